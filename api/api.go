@@ -2,6 +2,7 @@ package api
 
 import (
 	"log"
+	"log/slog"
 	"net"
 
 	"budgeting-service/internal/items/config"
@@ -10,21 +11,27 @@ import (
 	account_pb "budgeting-service/genproto/account"
 	budget_pb "budgeting-service/genproto/budget"
 	category_pb "budgeting-service/genproto/category"
+	currency_pb "budgeting-service/genproto/currency"
 	goal_pb "budgeting-service/genproto/goal"
 	notification_pb "budgeting-service/genproto/notification"
 	report_pb "budgeting-service/genproto/report"
 	transaction_pb "budgeting-service/genproto/transaction"
 
+	"github.com/casbin/casbin/v2"
 	"google.golang.org/grpc"
 )
 
 type API struct {
-	service *service.Service
+	service  *service.Service
+	enforcer *casbin.Enforcer
+	logger   *slog.Logger
 }
 
-func New(service *service.Service) *API {
+func New(service *service.Service, enforcer *casbin.Enforcer, logger *slog.Logger) *API {
 	return &API{
-		service: service,
+		service:  service,
+		enforcer: enforcer,
+		logger:   logger,
 	}
 }
 
@@ -34,11 +41,21 @@ func (a *API) RUN(config *config.Config, service *service.Service) error {
 		return err
 	}
 
-	serverRegisterer := grpc.NewServer()
+	serverRegisterer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			UnaryAuthInterceptor(config.JWT.SecretKey),
+			UnaryRBACInterceptor(a.enforcer, a.logger),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamAuthInterceptor(config.JWT.SecretKey),
+			StreamRBACInterceptor(a.enforcer, a.logger),
+		),
+	)
 
 	account_pb.RegisterAccountServiceServer(serverRegisterer, service.AccountService)
 	budget_pb.RegisterBudgetServiceServer(serverRegisterer, service.BudgetService)
 	category_pb.RegisterCategoryServiceServer(serverRegisterer, service.CategoryService)
+	currency_pb.RegisterCurrencyServiceServer(serverRegisterer, service.CurrencyService)
 	goal_pb.RegisterGoalServiceServer(serverRegisterer, service.GoalService)
 	notification_pb.RegisterNotificationServiceServer(serverRegisterer, service.NotificationService)
 	report_pb.RegisterReportServiceServer(serverRegisterer, service.ReportService)
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+
+	"github.com/casbin/casbin/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRBACInterceptor lets a call through only when the policy grants the
+// caller's role the method. It must run after the auth interceptor, which
+// puts the claims into the context.
+func UnaryRBACInterceptor(enforcer *casbin.Enforcer, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, enforcer, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamRBACInterceptor(enforcer *casbin.Enforcer, logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), enforcer, logger, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize checks the method against the policy and, when the role may call
+// it for any user, marks the context so the services skip the ownership check.
+func authorize(ctx context.Context, enforcer *casbin.Enforcer, logger *slog.Logger, method string) (context.Context, error) {
+	claims, ok := jwttokens.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	allowed, err := enforcer.Enforce(claims.Role, method, rbac.ScopeOwn)
+	if err != nil {
+		logger.Error("Error evaluating policy", slog.String("method", method), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "could not evaluate access policy")
+	}
+	if !allowed {
+		logger.Warn("Access denied by policy",
+			slog.String("user_id", claims.UserID),
+			slog.String("username", claims.Username),
+			slog.String("role", claims.Role),
+			slog.String("method", method),
+		)
+		return nil, status.Errorf(codes.PermissionDenied, "role %q may not call %s", claims.Role, method)
+	}
+
+	anyUser, err := enforcer.Enforce(claims.Role, method, rbac.ScopeAny)
+	if err != nil {
+		logger.Error("Error evaluating policy", slog.String("method", method), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "could not evaluate access policy")
+	}
+	if anyUser {
+		ctx = rbac.WithAnyUser(ctx)
+	}
+
+	return ctx, nil
+}
@@ -10,7 +10,10 @@ import (
 
 	"budgeting-service/api"
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/exchange"
 	"budgeting-service/internal/items/msgbroker"
+	"budgeting-service/internal/items/rbac"
+	"budgeting-service/internal/items/scheduler"
 	"budgeting-service/internal/items/service"
 	"budgeting-service/internal/items/storage"
 	mdb "budgeting-service/internal/items/storage/mongodb"
@@ -35,11 +38,43 @@ func main() {
 		logger.Error("Error connecting to MongoDB", slog.String("err", err.Error()))
 	}
 
-	service := service.New(storage.New(
+	if err := mdb.MigrateMoney(context.Background(), db, logger); err != nil {
+		log.Fatalln("Error migrating money amounts:", err)
+	}
+	if err := mdb.MigrateGoalLedger(context.Background(), db, logger); err != nil {
+		log.Fatalln("Error opening goal ledgers:", err)
+	}
+
+	storage := storage.New(
 		db,
 		config,
 		logger,
-	), logger)
+	)
+
+	if err := storage.Transaction().EnsureIndexes(context.Background()); err != nil {
+		logger.Error("Error creating transaction indexes", slog.String("err", err.Error()))
+	}
+	if err := storage.Currency().EnsureIndexes(context.Background()); err != nil {
+		logger.Error("Error creating currency indexes", slog.String("err", err.Error()))
+	}
+	if err := storage.Category().EnsureIndexes(context.Background()); err != nil {
+		logger.Error("Error creating category indexes", slog.String("err", err.Error()))
+	}
+	if err := storage.Rule().EnsureIndexes(context.Background()); err != nil {
+		logger.Error("Error creating rule indexes", slog.String("err", err.Error()))
+	}
+
+	if config.Currency.RatesFile != "" {
+		provider := exchange.NewFileProvider(config.Currency.RatesFile)
+		rates, err := provider.Rates(context.Background())
+		if err != nil {
+			logger.Error("Error loading exchange rates", slog.String("err", err.Error()))
+		} else if _, err := storage.Currency().SaveExchangeRates(context.Background(), rates, provider.Name()); err != nil {
+			logger.Error("Error saving exchange rates", slog.String("err", err.Error()))
+		}
+	}
+
+	service := service.New(storage, logger)
 
 	time.Sleep(10 * time.Second)
 
@@ -47,11 +82,18 @@ func main() {
 
 	msgBroker := msgbroker.New(service, logger, msgBrokers, &sync.WaitGroup{})
 
-	api := api.New(service)
+	enforcer, err := rbac.NewEnforcer(config.Casbin.ModelPath, config.Casbin.PolicyPath)
+	if err != nil {
+		log.Fatalln("Error loading access policy:", err)
+	}
+
+	api := api.New(service, enforcer, logger)
 
 	go func() {
 		log.Fatalln(api.RUN(config, service))
 	}()
 
+	go scheduler.New(storage, service, logger, time.Minute).Start(context.Background())
+
 	msgBroker.StartToConsume(context.Background())
 }
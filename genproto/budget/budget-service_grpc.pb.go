@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	BudgetService_CreateBudget_FullMethodName  = "/budget.BudgetService/CreateBudget"
-	BudgetService_GetBudgets_FullMethodName    = "/budget.BudgetService/GetBudgets"
-	BudgetService_GetBudgetById_FullMethodName = "/budget.BudgetService/GetBudgetById"
-	BudgetService_UpdateBudget_FullMethodName  = "/budget.BudgetService/UpdateBudget"
-	BudgetService_DeleteBudget_FullMethodName  = "/budget.BudgetService/DeleteBudget"
+	BudgetService_CreateBudget_FullMethodName         = "/budget.BudgetService/CreateBudget"
+	BudgetService_GetBudgets_FullMethodName           = "/budget.BudgetService/GetBudgets"
+	BudgetService_GetBudgetById_FullMethodName        = "/budget.BudgetService/GetBudgetById"
+	BudgetService_UpdateBudget_FullMethodName         = "/budget.BudgetService/UpdateBudget"
+	BudgetService_DeleteBudget_FullMethodName         = "/budget.BudgetService/DeleteBudget"
+	BudgetService_GetBudgetPeriods_FullMethodName     = "/budget.BudgetService/GetBudgetPeriods"
+	BudgetService_AssignToEnvelope_FullMethodName     = "/budget.BudgetService/AssignToEnvelope"
+	BudgetService_MoveBetweenEnvelopes_FullMethodName = "/budget.BudgetService/MoveBetweenEnvelopes"
+	BudgetService_GetEnvelopeSummary_FullMethodName   = "/budget.BudgetService/GetEnvelopeSummary"
 )
 
 // BudgetServiceClient is the client API for BudgetService service.
@@ -35,6 +39,10 @@ type BudgetServiceClient interface {
 	GetBudgetById(ctx context.Context, in *GetBudgetByIdRequest, opts ...grpc.CallOption) (*BudgetResponse, error)
 	UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*BudgetResponse, error)
 	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetBudgetPeriods(ctx context.Context, in *GetBudgetPeriodsRequest, opts ...grpc.CallOption) (*BudgetPeriodsResponse, error)
+	AssignToEnvelope(ctx context.Context, in *AssignToEnvelopeRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error)
+	MoveBetweenEnvelopes(ctx context.Context, in *MoveBetweenEnvelopesRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error)
+	GetEnvelopeSummary(ctx context.Context, in *GetEnvelopeSummaryRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error)
 }
 
 type budgetServiceClient struct {
@@ -95,6 +103,46 @@ func (c *budgetServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudget
 	return out, nil
 }
 
+func (c *budgetServiceClient) GetBudgetPeriods(ctx context.Context, in *GetBudgetPeriodsRequest, opts ...grpc.CallOption) (*BudgetPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetPeriodsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) AssignToEnvelope(ctx context.Context, in *AssignToEnvelopeRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvelopeSummaryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_AssignToEnvelope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) MoveBetweenEnvelopes(ctx context.Context, in *MoveBetweenEnvelopesRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvelopeSummaryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_MoveBetweenEnvelopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetEnvelopeSummary(ctx context.Context, in *GetEnvelopeSummaryRequest, opts ...grpc.CallOption) (*EnvelopeSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvelopeSummaryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetEnvelopeSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BudgetServiceServer is the server API for BudgetService service.
 // All implementations must embed UnimplementedBudgetServiceServer
 // for forward compatibility
@@ -104,6 +152,10 @@ type BudgetServiceServer interface {
 	GetBudgetById(context.Context, *GetBudgetByIdRequest) (*BudgetResponse, error)
 	UpdateBudget(context.Context, *UpdateBudgetRequest) (*BudgetResponse, error)
 	DeleteBudget(context.Context, *DeleteBudgetRequest) (*Empty, error)
+	GetBudgetPeriods(context.Context, *GetBudgetPeriodsRequest) (*BudgetPeriodsResponse, error)
+	AssignToEnvelope(context.Context, *AssignToEnvelopeRequest) (*EnvelopeSummaryResponse, error)
+	MoveBetweenEnvelopes(context.Context, *MoveBetweenEnvelopesRequest) (*EnvelopeSummaryResponse, error)
+	GetEnvelopeSummary(context.Context, *GetEnvelopeSummaryRequest) (*EnvelopeSummaryResponse, error)
 	mustEmbedUnimplementedBudgetServiceServer()
 }
 
@@ -126,6 +178,18 @@ func (UnimplementedBudgetServiceServer) UpdateBudget(context.Context, *UpdateBud
 func (UnimplementedBudgetServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
 }
+func (UnimplementedBudgetServiceServer) GetBudgetPeriods(context.Context, *GetBudgetPeriodsRequest) (*BudgetPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetPeriods not implemented")
+}
+func (UnimplementedBudgetServiceServer) AssignToEnvelope(context.Context, *AssignToEnvelopeRequest) (*EnvelopeSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignToEnvelope not implemented")
+}
+func (UnimplementedBudgetServiceServer) MoveBetweenEnvelopes(context.Context, *MoveBetweenEnvelopesRequest) (*EnvelopeSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBetweenEnvelopes not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetEnvelopeSummary(context.Context, *GetEnvelopeSummaryRequest) (*EnvelopeSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvelopeSummary not implemented")
+}
 func (UnimplementedBudgetServiceServer) mustEmbedUnimplementedBudgetServiceServer() {}
 
 // UnsafeBudgetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +293,78 @@ func _BudgetService_DeleteBudget_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetService_GetBudgetPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetPeriods(ctx, req.(*GetBudgetPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_AssignToEnvelope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignToEnvelopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).AssignToEnvelope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_AssignToEnvelope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).AssignToEnvelope(ctx, req.(*AssignToEnvelopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_MoveBetweenEnvelopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBetweenEnvelopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).MoveBetweenEnvelopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_MoveBetweenEnvelopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).MoveBetweenEnvelopes(ctx, req.(*MoveBetweenEnvelopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetEnvelopeSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvelopeSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetEnvelopeSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetEnvelopeSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetEnvelopeSummary(ctx, req.(*GetEnvelopeSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,6 +392,22 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBudget",
 			Handler:    _BudgetService_DeleteBudget_Handler,
 		},
+		{
+			MethodName: "GetBudgetPeriods",
+			Handler:    _BudgetService_GetBudgetPeriods_Handler,
+		},
+		{
+			MethodName: "AssignToEnvelope",
+			Handler:    _BudgetService_AssignToEnvelope_Handler,
+		},
+		{
+			MethodName: "MoveBetweenEnvelopes",
+			Handler:    _BudgetService_MoveBetweenEnvelopes_Handler,
+		},
+		{
+			MethodName: "GetEnvelopeSummary",
+			Handler:    _BudgetService_GetEnvelopeSummary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "budget-service/budget-service.proto",
@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	CategoryService_CreateCategory_FullMethodName  = "/category.CategoryService/CreateCategory"
-	CategoryService_GetCategories_FullMethodName   = "/category.CategoryService/GetCategories"
-	CategoryService_GetCategoryById_FullMethodName = "/category.CategoryService/GetCategoryById"
-	CategoryService_UpdateCategory_FullMethodName  = "/category.CategoryService/UpdateCategory"
-	CategoryService_DeleteCategory_FullMethodName  = "/category.CategoryService/DeleteCategory"
+	CategoryService_CreateCategory_FullMethodName        = "/category.CategoryService/CreateCategory"
+	CategoryService_GetCategories_FullMethodName         = "/category.CategoryService/GetCategories"
+	CategoryService_GetCategoryById_FullMethodName       = "/category.CategoryService/GetCategoryById"
+	CategoryService_UpdateCategory_FullMethodName        = "/category.CategoryService/UpdateCategory"
+	CategoryService_DeleteCategory_FullMethodName        = "/category.CategoryService/DeleteCategory"
+	CategoryService_MoveCategory_FullMethodName          = "/category.CategoryService/MoveCategory"
+	CategoryService_GetCategoryTree_FullMethodName       = "/category.CategoryService/GetCategoryTree"
+	CategoryService_SeedDefaultCategories_FullMethodName = "/category.CategoryService/SeedDefaultCategories"
+	CategoryService_PushDefaultCategories_FullMethodName = "/category.CategoryService/PushDefaultCategories"
+	CategoryService_MergeCategories_FullMethodName       = "/category.CategoryService/MergeCategories"
+	CategoryService_ArchiveCategory_FullMethodName       = "/category.CategoryService/ArchiveCategory"
 )
 
 // CategoryServiceClient is the client API for CategoryService service.
@@ -35,6 +41,12 @@ type CategoryServiceClient interface {
 	GetCategoryById(ctx context.Context, in *GetCategoryByIdRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error)
+	SeedDefaultCategories(ctx context.Context, in *SeedDefaultCategoriesRequest, opts ...grpc.CallOption) (*SeedDefaultCategoriesResponse, error)
+	PushDefaultCategories(ctx context.Context, in *PushDefaultCategoriesRequest, opts ...grpc.CallOption) (*PushDefaultCategoriesResponse, error)
+	MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
 }
 
 type categoryServiceClient struct {
@@ -95,6 +107,66 @@ func (c *categoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCa
 	return out, nil
 }
 
+func (c *categoryServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTreeResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) SeedDefaultCategories(ctx context.Context, in *SeedDefaultCategoriesRequest, opts ...grpc.CallOption) (*SeedDefaultCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedDefaultCategoriesResponse)
+	err := c.cc.Invoke(ctx, CategoryService_SeedDefaultCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) PushDefaultCategories(ctx context.Context, in *PushDefaultCategoriesRequest, opts ...grpc.CallOption) (*PushDefaultCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushDefaultCategoriesResponse)
+	err := c.cc.Invoke(ctx, CategoryService_PushDefaultCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_ArchiveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility
@@ -104,6 +176,12 @@ type CategoryServiceServer interface {
 	GetCategoryById(context.Context, *GetCategoryByIdRequest) (*CategoryResponse, error)
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error)
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*CategoryResponse, error)
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*CategoryTreeResponse, error)
+	SeedDefaultCategories(context.Context, *SeedDefaultCategoriesRequest) (*SeedDefaultCategoriesResponse, error)
+	PushDefaultCategories(context.Context, *PushDefaultCategoriesRequest) (*PushDefaultCategoriesResponse, error)
+	MergeCategories(context.Context, *MergeCategoriesRequest) (*CategoryResponse, error)
+	ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*CategoryResponse, error)
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -126,6 +204,24 @@ func (UnimplementedCategoryServiceServer) UpdateCategory(context.Context, *Updat
 func (UnimplementedCategoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
 }
+func (UnimplementedCategoryServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*CategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedCategoryServiceServer) SeedDefaultCategories(context.Context, *SeedDefaultCategoriesRequest) (*SeedDefaultCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedDefaultCategories not implemented")
+}
+func (UnimplementedCategoryServiceServer) PushDefaultCategories(context.Context, *PushDefaultCategoriesRequest) (*PushDefaultCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDefaultCategories not implemented")
+}
+func (UnimplementedCategoryServiceServer) MergeCategories(context.Context, *MergeCategoriesRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCategories not implemented")
+}
+func (UnimplementedCategoryServiceServer) ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCategory not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 
 // UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +325,114 @@ func _CategoryService_DeleteCategory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_SeedDefaultCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeedDefaultCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).SeedDefaultCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_SeedDefaultCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).SeedDefaultCategories(ctx, req.(*SeedDefaultCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_PushDefaultCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushDefaultCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).PushDefaultCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_PushDefaultCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).PushDefaultCategories(ctx, req.(*PushDefaultCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).MergeCategories(ctx, req.(*MergeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_ArchiveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ArchiveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_ArchiveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).ArchiveCategory(ctx, req.(*ArchiveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,6 +460,30 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _CategoryService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _CategoryService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _CategoryService_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "SeedDefaultCategories",
+			Handler:    _CategoryService_SeedDefaultCategories_Handler,
+		},
+		{
+			MethodName: "PushDefaultCategories",
+			Handler:    _CategoryService_PushDefaultCategories_Handler,
+		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _CategoryService_MergeCategories_Handler,
+		},
+		{
+			MethodName: "ArchiveCategory",
+			Handler:    _CategoryService_ArchiveCategory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "category-service/category-service.proto",
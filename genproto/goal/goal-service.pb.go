@@ -7,6 +7,7 @@
 package goal
 
 import (
+	common "budgeting-service/genproto/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,12 +26,15 @@ type CreateGoalRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TargetAmount  float32 `protobuf:"fixed32,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	CurrentAmount float32 `protobuf:"fixed32,4,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
-	Deadline      string  `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Status        string  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	UserId        string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  *common.Money `protobuf:"bytes,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount *common.Money `protobuf:"bytes,4,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	Deadline      string        `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status        string        `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Tracking      string        `protobuf:"bytes,7,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	AccountIds    []string      `protobuf:"bytes,8,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Milestones    []*Milestone  `protobuf:"bytes,9,rep,name=milestones,proto3" json:"milestones,omitempty"`
 }
 
 func (x *CreateGoalRequest) Reset() {
@@ -79,59 +83,657 @@ func (x *CreateGoalRequest) GetName() string {
 	return ""
 }
 
-func (x *CreateGoalRequest) GetTargetAmount() float32 {
+func (x *CreateGoalRequest) GetTargetAmount() *common.Money {
 	if x != nil {
 		return x.TargetAmount
 	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetCurrentAmount() *common.Money {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetTracking() string {
+	if x != nil {
+		return x.Tracking
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetMilestones() []*Milestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+type GetGoalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Search    string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	SortBy    string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder string `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	PageSize  int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetGoalsRequest) Reset() {
+	*x = GetGoalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalsRequest) ProtoMessage() {}
+
+func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGoalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGoalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetGoalsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetGoalsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetGoalsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetGoalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
 	return 0
 }
 
-func (x *CreateGoalRequest) GetCurrentAmount() float32 {
-	if x != nil {
-		return x.CurrentAmount
-	}
-	return 0
+func (x *GetGoalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGoalByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetGoalByIdRequest) Reset() {
+	*x = GetGoalByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoalByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalByIdRequest) ProtoMessage() {}
+
+func (x *GetGoalByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalByIdRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetGoalByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  *common.Money `protobuf:"bytes,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount *common.Money `protobuf:"bytes,4,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	Deadline      string        `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status        string        `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Tracking      string        `protobuf:"bytes,7,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	AccountIds    []string      `protobuf:"bytes,8,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Milestones    []*Milestone  `protobuf:"bytes,9,rep,name=milestones,proto3" json:"milestones,omitempty"`
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateGoalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetTargetAmount() *common.Money {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetCurrentAmount() *common.Money {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetTracking() string {
+	if x != nil {
+		return x.Tracking
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetMilestones() []*Milestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteGoalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GoalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string        `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  *common.Money `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount *common.Money `protobuf:"bytes,5,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	Deadline      string        `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status        string        `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string        `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string        `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tracking      string        `protobuf:"bytes,10,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	AccountIds    []string      `protobuf:"bytes,11,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	AchievedAt    string        `protobuf:"bytes,12,opt,name=achieved_at,json=achievedAt,proto3" json:"achieved_at,omitempty"`
+	Milestones    []*Milestone  `protobuf:"bytes,13,rep,name=milestones,proto3" json:"milestones,omitempty"`
+}
+
+func (x *GoalResponse) Reset() {
+	*x = GoalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalResponse) ProtoMessage() {}
+
+func (x *GoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalResponse.ProtoReflect.Descriptor instead.
+func (*GoalResponse) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GoalResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetTargetAmount() *common.Money {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *GoalResponse) GetCurrentAmount() *common.Money {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *GoalResponse) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetTracking() string {
+	if x != nil {
+		return x.Tracking
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GoalResponse) GetAchievedAt() string {
+	if x != nil {
+		return x.AchievedAt
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetMilestones() []*Milestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+type Milestone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Percent    int32         `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount     *common.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TargetDate string        `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3" json:"target_date,omitempty"`
+	ReachedAt  string        `protobuf:"bytes,5,opt,name=reached_at,json=reachedAt,proto3" json:"reached_at,omitempty"`
+}
+
+func (x *Milestone) Reset() {
+	*x = Milestone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Milestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Milestone) ProtoMessage() {}
+
+func (x *Milestone) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Milestone.ProtoReflect.Descriptor instead.
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Milestone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Milestone) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Milestone) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Milestone) GetTargetDate() string {
+	if x != nil {
+		return x.TargetDate
+	}
+	return ""
+}
+
+func (x *Milestone) GetReachedAt() string {
+	if x != nil {
+		return x.ReachedAt
+	}
+	return ""
+}
+
+type GoalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goals         []*GoalResponse `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GoalsResponse) Reset() {
+	*x = GoalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_service_goal_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalsResponse) ProtoMessage() {}
+
+func (x *GoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalsResponse.ProtoReflect.Descriptor instead.
+func (*GoalsResponse) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateGoalRequest) GetDeadline() string {
+func (x *GoalsResponse) GetGoals() []*GoalResponse {
 	if x != nil {
-		return x.Deadline
+		return x.Goals
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGoalRequest) GetStatus() string {
+func (x *GoalsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Status
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type GetGoalsRequest struct {
+type ProjectGoalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetGoalsRequest) Reset() {
-	*x = GetGoalsRequest{}
+func (x *ProjectGoalRequest) Reset() {
+	*x = ProjectGoalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[1]
+		mi := &file_goal_service_goal_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGoalsRequest) String() string {
+func (x *ProjectGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGoalsRequest) ProtoMessage() {}
+func (*ProjectGoalRequest) ProtoMessage() {}
 
-func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[1]
+func (x *ProjectGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -142,43 +744,49 @@ func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGoalsRequest.ProtoReflect.Descriptor instead.
-func (*GetGoalsRequest) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ProjectGoalRequest.ProtoReflect.Descriptor instead.
+func (*ProjectGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetGoalsRequest) GetUserId() string {
+func (x *ProjectGoalRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-type GetGoalByIdRequest struct {
+type GoalProjectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoalId                  string        `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Remaining               *common.Money `protobuf:"bytes,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	MonthlyRequired         *common.Money `protobuf:"bytes,3,opt,name=monthly_required,json=monthlyRequired,proto3" json:"monthly_required,omitempty"`
+	WeeklyRequired          *common.Money `protobuf:"bytes,4,opt,name=weekly_required,json=weeklyRequired,proto3" json:"weekly_required,omitempty"`
+	MonthlyRate             *common.Money `protobuf:"bytes,5,opt,name=monthly_rate,json=monthlyRate,proto3" json:"monthly_rate,omitempty"`
+	ProjectedCompletionDate string        `protobuf:"bytes,6,opt,name=projected_completion_date,json=projectedCompletionDate,proto3" json:"projected_completion_date,omitempty"`
+	Pace                    string        `protobuf:"bytes,7,opt,name=pace,proto3" json:"pace,omitempty"`
 }
 
-func (x *GetGoalByIdRequest) Reset() {
-	*x = GetGoalByIdRequest{}
+func (x *GoalProjectionResponse) Reset() {
+	*x = GoalProjectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[2]
+		mi := &file_goal_service_goal_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGoalByIdRequest) String() string {
+func (x *GoalProjectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGoalByIdRequest) ProtoMessage() {}
+func (*GoalProjectionResponse) ProtoMessage() {}
 
-func (x *GetGoalByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[2]
+func (x *GoalProjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,48 +797,89 @@ func (x *GetGoalByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGoalByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGoalByIdRequest) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GoalProjectionResponse.ProtoReflect.Descriptor instead.
+func (*GoalProjectionResponse) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetGoalByIdRequest) GetId() string {
+func (x *GoalProjectionResponse) GetGoalId() string {
 	if x != nil {
-		return x.Id
+		return x.GoalId
 	}
 	return ""
 }
 
-type UpdateGoalRequest struct {
+func (x *GoalProjectionResponse) GetRemaining() *common.Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *GoalProjectionResponse) GetMonthlyRequired() *common.Money {
+	if x != nil {
+		return x.MonthlyRequired
+	}
+	return nil
+}
+
+func (x *GoalProjectionResponse) GetWeeklyRequired() *common.Money {
+	if x != nil {
+		return x.WeeklyRequired
+	}
+	return nil
+}
+
+func (x *GoalProjectionResponse) GetMonthlyRate() *common.Money {
+	if x != nil {
+		return x.MonthlyRate
+	}
+	return nil
+}
+
+func (x *GoalProjectionResponse) GetProjectedCompletionDate() string {
+	if x != nil {
+		return x.ProjectedCompletionDate
+	}
+	return ""
+}
+
+func (x *GoalProjectionResponse) GetPace() string {
+	if x != nil {
+		return x.Pace
+	}
+	return ""
+}
+
+type AddGoalContributionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TargetAmount  float32 `protobuf:"fixed32,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	CurrentAmount float32 `protobuf:"fixed32,4,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
-	Deadline      string  `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Status        string  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	GoalId    string        `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Amount    *common.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	AccountId string        `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Note      string        `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (x *UpdateGoalRequest) Reset() {
-	*x = UpdateGoalRequest{}
+func (x *AddGoalContributionRequest) Reset() {
+	*x = AddGoalContributionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[3]
+		mi := &file_goal_service_goal_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateGoalRequest) String() string {
+func (x *AddGoalContributionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGoalRequest) ProtoMessage() {}
+func (*AddGoalContributionRequest) ProtoMessage() {}
 
-func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[3]
+func (x *AddGoalContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,78 +890,73 @@ func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *UpdateGoalRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use AddGoalContributionRequest.ProtoReflect.Descriptor instead.
+func (*AddGoalContributionRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateGoalRequest) GetName() string {
+func (x *AddGoalContributionRequest) GetGoalId() string {
 	if x != nil {
-		return x.Name
+		return x.GoalId
 	}
 	return ""
 }
 
-func (x *UpdateGoalRequest) GetTargetAmount() float32 {
+func (x *AddGoalContributionRequest) GetAmount() *common.Money {
 	if x != nil {
-		return x.TargetAmount
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateGoalRequest) GetCurrentAmount() float32 {
+func (x *AddGoalContributionRequest) GetType() string {
 	if x != nil {
-		return x.CurrentAmount
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateGoalRequest) GetDeadline() string {
+func (x *AddGoalContributionRequest) GetAccountId() string {
 	if x != nil {
-		return x.Deadline
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *UpdateGoalRequest) GetStatus() string {
+func (x *AddGoalContributionRequest) GetNote() string {
 	if x != nil {
-		return x.Status
+		return x.Note
 	}
 	return ""
 }
 
-type DeleteGoalRequest struct {
+type GetGoalContributionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoalId    string `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *DeleteGoalRequest) Reset() {
-	*x = DeleteGoalRequest{}
+func (x *GetGoalContributionsRequest) Reset() {
+	*x = GetGoalContributionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[4]
+		mi := &file_goal_service_goal_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteGoalRequest) String() string {
+func (x *GetGoalContributionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGoalRequest) ProtoMessage() {}
+func (*GetGoalContributionsRequest) ProtoMessage() {}
 
-func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[4]
+func (x *GetGoalContributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,51 +967,65 @@ func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetGoalContributionsRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalContributionsRequest) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteGoalRequest) GetId() string {
+func (x *GetGoalContributionsRequest) GetGoalId() string {
 	if x != nil {
-		return x.Id
+		return x.GoalId
 	}
 	return ""
 }
 
-type GoalResponse struct {
+func (x *GetGoalContributionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGoalContributionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GoalContribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	TargetAmount  float32 `protobuf:"fixed32,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	CurrentAmount float32 `protobuf:"fixed32,5,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
-	Deadline      string  `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Status        string  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string  `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoalId        string        `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Amount        *common.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          string        `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Source        string        `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	AccountId     string        `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TransactionId string        `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Note          string        `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string        `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *GoalResponse) Reset() {
-	*x = GoalResponse{}
+func (x *GoalContribution) Reset() {
+	*x = GoalContribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[5]
+		mi := &file_goal_service_goal_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GoalResponse) String() string {
+func (x *GoalContribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoalResponse) ProtoMessage() {}
+func (*GoalContribution) ProtoMessage() {}
 
-func (x *GoalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[5]
+func (x *GoalContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,99 +1036,100 @@ func (x *GoalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoalResponse.ProtoReflect.Descriptor instead.
-func (*GoalResponse) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GoalContribution.ProtoReflect.Descriptor instead.
+func (*GoalContribution) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GoalResponse) GetId() string {
+func (x *GoalContribution) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GoalResponse) GetUserId() string {
+func (x *GoalContribution) GetGoalId() string {
 	if x != nil {
-		return x.UserId
+		return x.GoalId
 	}
 	return ""
 }
 
-func (x *GoalResponse) GetName() string {
+func (x *GoalContribution) GetAmount() *common.Money {
 	if x != nil {
-		return x.Name
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *GoalResponse) GetTargetAmount() float32 {
+func (x *GoalContribution) GetType() string {
 	if x != nil {
-		return x.TargetAmount
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *GoalResponse) GetCurrentAmount() float32 {
+func (x *GoalContribution) GetSource() string {
 	if x != nil {
-		return x.CurrentAmount
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *GoalResponse) GetDeadline() string {
+func (x *GoalContribution) GetAccountId() string {
 	if x != nil {
-		return x.Deadline
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *GoalResponse) GetStatus() string {
+func (x *GoalContribution) GetTransactionId() string {
 	if x != nil {
-		return x.Status
+		return x.TransactionId
 	}
 	return ""
 }
 
-func (x *GoalResponse) GetCreatedAt() string {
+func (x *GoalContribution) GetNote() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Note
 	}
 	return ""
 }
 
-func (x *GoalResponse) GetUpdatedAt() string {
+func (x *GoalContribution) GetCreatedAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type GoalsResponse struct {
+type GoalContributionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Goals []*GoalResponse `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	Contributions []*GoalContribution `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *GoalsResponse) Reset() {
-	*x = GoalsResponse{}
+func (x *GoalContributionsResponse) Reset() {
+	*x = GoalContributionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[6]
+		mi := &file_goal_service_goal_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GoalsResponse) String() string {
+func (x *GoalContributionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoalsResponse) ProtoMessage() {}
+func (*GoalContributionsResponse) ProtoMessage() {}
 
-func (x *GoalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[6]
+func (x *GoalContributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_service_goal_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,18 +1140,25 @@ func (x *GoalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoalsResponse.ProtoReflect.Descriptor instead.
-func (*GoalsResponse) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GoalContributionsResponse.ProtoReflect.Descriptor instead.
+func (*GoalContributionsResponse) Descriptor() ([]byte, []int) {
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GoalsResponse) GetGoals() []*GoalResponse {
+func (x *GoalContributionsResponse) GetContributions() []*GoalContribution {
 	if x != nil {
-		return x.Goals
+		return x.Contributions
 	}
 	return nil
 }
 
+func (x *GoalContributionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -502,7 +1168,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_goal_service_goal_service_proto_msgTypes[7]
+		mi := &file_goal_service_goal_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +1181,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_goal_service_goal_service_proto_msgTypes[7]
+	mi := &file_goal_service_goal_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +1194,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{7}
+	return file_goal_service_goal_service_proto_rawDescGZIP(), []int{14}
 }
 
 var File_goal_service_goal_service_proto protoreflect.FileDescriptor
@@ -536,80 +1202,214 @@ var File_goal_service_goal_service_proto protoreflect.FileDescriptor
 var file_goal_service_goal_service_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x67, 0x6f, 0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67,
 	0x6f, 0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61,
-	0x6c, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb7, 0x01, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x0c,
-	0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41,
+	0x6f, 0x12, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x1a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x02, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a,
+	0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xc3, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41,
 	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x47, 0x6f, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47,
-	0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x67, 0x6f, 0x61,
-	0x6c, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xac, 0x02, 0x0a, 0x0b,
-	0x47, 0x6f, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x6c,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61,
-	0x6c, 0x73, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x6c,
-	0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x12, 0x18, 0x2e,
-	0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47,
-	0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x6c,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x6f, 0x61, 0x6c, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
-	0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2f,
-	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x61, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x61,
+	0x6c, 0x2e, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb6, 0x03, 0x0a,
+	0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x0d, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd2, 0x02,
+	0x0a, 0x16, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x38,
+	0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0f, 0x77, 0x65, 0x65, 0x6b,
+	0x6c, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0e, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x30, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x72, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47,
+	0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x02, 0x0a,
+	0x10, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x47, 0x6f, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x32, 0xa0, 0x04, 0x0a, 0x0b, 0x47, 0x6f, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x61,
+	0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x61,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61,
+	0x6c, 0x42, 0x79, 0x49, 0x64, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x6f, 0x61, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x61,
+	0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x17, 0x2e, 0x67,
+	0x6f, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x13, 0x41, 0x64, 0x64,
+	0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -624,34 +1424,65 @@ func file_goal_service_goal_service_proto_rawDescGZIP() []byte {
 	return file_goal_service_goal_service_proto_rawDescData
 }
 
-var file_goal_service_goal_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_goal_service_goal_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_goal_service_goal_service_proto_goTypes = []any{
-	(*CreateGoalRequest)(nil),  // 0: goal.CreateGoalRequest
-	(*GetGoalsRequest)(nil),    // 1: goal.GetGoalsRequest
-	(*GetGoalByIdRequest)(nil), // 2: goal.GetGoalByIdRequest
-	(*UpdateGoalRequest)(nil),  // 3: goal.UpdateGoalRequest
-	(*DeleteGoalRequest)(nil),  // 4: goal.DeleteGoalRequest
-	(*GoalResponse)(nil),       // 5: goal.GoalResponse
-	(*GoalsResponse)(nil),      // 6: goal.GoalsResponse
-	(*Empty)(nil),              // 7: goal.Empty
+	(*CreateGoalRequest)(nil),           // 0: goal.CreateGoalRequest
+	(*GetGoalsRequest)(nil),             // 1: goal.GetGoalsRequest
+	(*GetGoalByIdRequest)(nil),          // 2: goal.GetGoalByIdRequest
+	(*UpdateGoalRequest)(nil),           // 3: goal.UpdateGoalRequest
+	(*DeleteGoalRequest)(nil),           // 4: goal.DeleteGoalRequest
+	(*GoalResponse)(nil),                // 5: goal.GoalResponse
+	(*Milestone)(nil),                   // 6: goal.Milestone
+	(*GoalsResponse)(nil),               // 7: goal.GoalsResponse
+	(*ProjectGoalRequest)(nil),          // 8: goal.ProjectGoalRequest
+	(*GoalProjectionResponse)(nil),      // 9: goal.GoalProjectionResponse
+	(*AddGoalContributionRequest)(nil),  // 10: goal.AddGoalContributionRequest
+	(*GetGoalContributionsRequest)(nil), // 11: goal.GetGoalContributionsRequest
+	(*GoalContribution)(nil),            // 12: goal.GoalContribution
+	(*GoalContributionsResponse)(nil),   // 13: goal.GoalContributionsResponse
+	(*Empty)(nil),                       // 14: goal.Empty
+	(*common.Money)(nil),                // 15: common.Money
 }
 var file_goal_service_goal_service_proto_depIdxs = []int32{
-	5, // 0: goal.GoalsResponse.goals:type_name -> goal.GoalResponse
-	0, // 1: goal.GoalService.CreateGoal:input_type -> goal.CreateGoalRequest
-	1, // 2: goal.GoalService.GetGoals:input_type -> goal.GetGoalsRequest
-	2, // 3: goal.GoalService.GetGoalById:input_type -> goal.GetGoalByIdRequest
-	3, // 4: goal.GoalService.UpdateGoal:input_type -> goal.UpdateGoalRequest
-	4, // 5: goal.GoalService.DeleteGoal:input_type -> goal.DeleteGoalRequest
-	5, // 6: goal.GoalService.CreateGoal:output_type -> goal.GoalResponse
-	6, // 7: goal.GoalService.GetGoals:output_type -> goal.GoalsResponse
-	5, // 8: goal.GoalService.GetGoalById:output_type -> goal.GoalResponse
-	5, // 9: goal.GoalService.UpdateGoal:output_type -> goal.GoalResponse
-	7, // 10: goal.GoalService.DeleteGoal:output_type -> goal.Empty
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	15, // 0: goal.CreateGoalRequest.target_amount:type_name -> common.Money
+	15, // 1: goal.CreateGoalRequest.current_amount:type_name -> common.Money
+	6,  // 2: goal.CreateGoalRequest.milestones:type_name -> goal.Milestone
+	15, // 3: goal.UpdateGoalRequest.target_amount:type_name -> common.Money
+	15, // 4: goal.UpdateGoalRequest.current_amount:type_name -> common.Money
+	6,  // 5: goal.UpdateGoalRequest.milestones:type_name -> goal.Milestone
+	15, // 6: goal.GoalResponse.target_amount:type_name -> common.Money
+	15, // 7: goal.GoalResponse.current_amount:type_name -> common.Money
+	6,  // 8: goal.GoalResponse.milestones:type_name -> goal.Milestone
+	15, // 9: goal.Milestone.amount:type_name -> common.Money
+	5,  // 10: goal.GoalsResponse.goals:type_name -> goal.GoalResponse
+	15, // 11: goal.GoalProjectionResponse.remaining:type_name -> common.Money
+	15, // 12: goal.GoalProjectionResponse.monthly_required:type_name -> common.Money
+	15, // 13: goal.GoalProjectionResponse.weekly_required:type_name -> common.Money
+	15, // 14: goal.GoalProjectionResponse.monthly_rate:type_name -> common.Money
+	15, // 15: goal.AddGoalContributionRequest.amount:type_name -> common.Money
+	15, // 16: goal.GoalContribution.amount:type_name -> common.Money
+	12, // 17: goal.GoalContributionsResponse.contributions:type_name -> goal.GoalContribution
+	0,  // 18: goal.GoalService.CreateGoal:input_type -> goal.CreateGoalRequest
+	1,  // 19: goal.GoalService.GetGoals:input_type -> goal.GetGoalsRequest
+	2,  // 20: goal.GoalService.GetGoalById:input_type -> goal.GetGoalByIdRequest
+	3,  // 21: goal.GoalService.UpdateGoal:input_type -> goal.UpdateGoalRequest
+	4,  // 22: goal.GoalService.DeleteGoal:input_type -> goal.DeleteGoalRequest
+	8,  // 23: goal.GoalService.ProjectGoal:input_type -> goal.ProjectGoalRequest
+	10, // 24: goal.GoalService.AddGoalContribution:input_type -> goal.AddGoalContributionRequest
+	11, // 25: goal.GoalService.GetGoalContributions:input_type -> goal.GetGoalContributionsRequest
+	5,  // 26: goal.GoalService.CreateGoal:output_type -> goal.GoalResponse
+	7,  // 27: goal.GoalService.GetGoals:output_type -> goal.GoalsResponse
+	5,  // 28: goal.GoalService.GetGoalById:output_type -> goal.GoalResponse
+	5,  // 29: goal.GoalService.UpdateGoal:output_type -> goal.GoalResponse
+	14, // 30: goal.GoalService.DeleteGoal:output_type -> goal.Empty
+	9,  // 31: goal.GoalService.ProjectGoal:output_type -> goal.GoalProjectionResponse
+	12, // 32: goal.GoalService.AddGoalContribution:output_type -> goal.GoalContribution
+	13, // 33: goal.GoalService.GetGoalContributions:output_type -> goal.GoalContributionsResponse
+	26, // [26:34] is the sub-list for method output_type
+	18, // [18:26] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_goal_service_goal_service_proto_init() }
@@ -733,7 +1564,7 @@ func file_goal_service_goal_service_proto_init() {
 			}
 		}
 		file_goal_service_goal_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GoalsResponse); i {
+			switch v := v.(*Milestone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -745,6 +1576,90 @@ func file_goal_service_goal_service_proto_init() {
 			}
 		}
 		file_goal_service_goal_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ProjectGoalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalProjectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*AddGoalContributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGoalContributionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GoalContributionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_service_goal_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -763,7 +1678,7 @@ func file_goal_service_goal_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_goal_service_goal_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
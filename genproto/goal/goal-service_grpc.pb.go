@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	GoalService_CreateGoal_FullMethodName  = "/goal.GoalService/CreateGoal"
-	GoalService_GetGoals_FullMethodName    = "/goal.GoalService/GetGoals"
-	GoalService_GetGoalById_FullMethodName = "/goal.GoalService/GetGoalById"
-	GoalService_UpdateGoal_FullMethodName  = "/goal.GoalService/UpdateGoal"
-	GoalService_DeleteGoal_FullMethodName  = "/goal.GoalService/DeleteGoal"
+	GoalService_CreateGoal_FullMethodName           = "/goal.GoalService/CreateGoal"
+	GoalService_GetGoals_FullMethodName             = "/goal.GoalService/GetGoals"
+	GoalService_GetGoalById_FullMethodName          = "/goal.GoalService/GetGoalById"
+	GoalService_UpdateGoal_FullMethodName           = "/goal.GoalService/UpdateGoal"
+	GoalService_DeleteGoal_FullMethodName           = "/goal.GoalService/DeleteGoal"
+	GoalService_ProjectGoal_FullMethodName          = "/goal.GoalService/ProjectGoal"
+	GoalService_AddGoalContribution_FullMethodName  = "/goal.GoalService/AddGoalContribution"
+	GoalService_GetGoalContributions_FullMethodName = "/goal.GoalService/GetGoalContributions"
 )
 
 // GoalServiceClient is the client API for GoalService service.
@@ -35,6 +38,9 @@ type GoalServiceClient interface {
 	GetGoalById(ctx context.Context, in *GetGoalByIdRequest, opts ...grpc.CallOption) (*GoalResponse, error)
 	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*GoalResponse, error)
 	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*Empty, error)
+	ProjectGoal(ctx context.Context, in *ProjectGoalRequest, opts ...grpc.CallOption) (*GoalProjectionResponse, error)
+	AddGoalContribution(ctx context.Context, in *AddGoalContributionRequest, opts ...grpc.CallOption) (*GoalContribution, error)
+	GetGoalContributions(ctx context.Context, in *GetGoalContributionsRequest, opts ...grpc.CallOption) (*GoalContributionsResponse, error)
 }
 
 type goalServiceClient struct {
@@ -95,6 +101,36 @@ func (c *goalServiceClient) DeleteGoal(ctx context.Context, in *DeleteGoalReques
 	return out, nil
 }
 
+func (c *goalServiceClient) ProjectGoal(ctx context.Context, in *ProjectGoalRequest, opts ...grpc.CallOption) (*GoalProjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalProjectionResponse)
+	err := c.cc.Invoke(ctx, GoalService_ProjectGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) AddGoalContribution(ctx context.Context, in *AddGoalContributionRequest, opts ...grpc.CallOption) (*GoalContribution, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalContribution)
+	err := c.cc.Invoke(ctx, GoalService_AddGoalContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) GetGoalContributions(ctx context.Context, in *GetGoalContributionsRequest, opts ...grpc.CallOption) (*GoalContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalContributionsResponse)
+	err := c.cc.Invoke(ctx, GoalService_GetGoalContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoalServiceServer is the server API for GoalService service.
 // All implementations must embed UnimplementedGoalServiceServer
 // for forward compatibility
@@ -104,6 +140,9 @@ type GoalServiceServer interface {
 	GetGoalById(context.Context, *GetGoalByIdRequest) (*GoalResponse, error)
 	UpdateGoal(context.Context, *UpdateGoalRequest) (*GoalResponse, error)
 	DeleteGoal(context.Context, *DeleteGoalRequest) (*Empty, error)
+	ProjectGoal(context.Context, *ProjectGoalRequest) (*GoalProjectionResponse, error)
+	AddGoalContribution(context.Context, *AddGoalContributionRequest) (*GoalContribution, error)
+	GetGoalContributions(context.Context, *GetGoalContributionsRequest) (*GoalContributionsResponse, error)
 	mustEmbedUnimplementedGoalServiceServer()
 }
 
@@ -126,6 +165,15 @@ func (UnimplementedGoalServiceServer) UpdateGoal(context.Context, *UpdateGoalReq
 func (UnimplementedGoalServiceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoal not implemented")
 }
+func (UnimplementedGoalServiceServer) ProjectGoal(context.Context, *ProjectGoalRequest) (*GoalProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGoal not implemented")
+}
+func (UnimplementedGoalServiceServer) AddGoalContribution(context.Context, *AddGoalContributionRequest) (*GoalContribution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGoalContribution not implemented")
+}
+func (UnimplementedGoalServiceServer) GetGoalContributions(context.Context, *GetGoalContributionsRequest) (*GoalContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoalContributions not implemented")
+}
 func (UnimplementedGoalServiceServer) mustEmbedUnimplementedGoalServiceServer() {}
 
 // UnsafeGoalServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +277,60 @@ func _GoalService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoalService_ProjectGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).ProjectGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_ProjectGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).ProjectGoal(ctx, req.(*ProjectGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_AddGoalContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGoalContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).AddGoalContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_AddGoalContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).AddGoalContribution(ctx, req.(*AddGoalContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_GetGoalContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).GetGoalContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_GetGoalContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).GetGoalContributions(ctx, req.(*GetGoalContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoalService_ServiceDesc is the grpc.ServiceDesc for GoalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,6 +358,18 @@ var GoalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGoal",
 			Handler:    _GoalService_DeleteGoal_Handler,
 		},
+		{
+			MethodName: "ProjectGoal",
+			Handler:    _GoalService_ProjectGoal_Handler,
+		},
+		{
+			MethodName: "AddGoalContribution",
+			Handler:    _GoalService_AddGoalContribution_Handler,
+		},
+		{
+			MethodName: "GetGoalContributions",
+			Handler:    _GoalService_GetGoalContributions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goal-service/goal-service.proto",
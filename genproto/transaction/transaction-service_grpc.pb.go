@@ -19,11 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	TransactionService_CreateTransaction_FullMethodName  = "/transaction.TransactionService/CreateTransaction"
-	TransactionService_GetTransactions_FullMethodName    = "/transaction.TransactionService/GetTransactions"
-	TransactionService_GetTransactionById_FullMethodName = "/transaction.TransactionService/GetTransactionById"
-	TransactionService_UpdateTransaction_FullMethodName  = "/transaction.TransactionService/UpdateTransaction"
-	TransactionService_DeleteTransaction_FullMethodName  = "/transaction.TransactionService/DeleteTransaction"
+	TransactionService_CreateTransaction_FullMethodName           = "/transaction.TransactionService/CreateTransaction"
+	TransactionService_GetTransactions_FullMethodName             = "/transaction.TransactionService/GetTransactions"
+	TransactionService_GetTransactionById_FullMethodName          = "/transaction.TransactionService/GetTransactionById"
+	TransactionService_UpdateTransaction_FullMethodName           = "/transaction.TransactionService/UpdateTransaction"
+	TransactionService_DeleteTransaction_FullMethodName           = "/transaction.TransactionService/DeleteTransaction"
+	TransactionService_CreateTransfer_FullMethodName              = "/transaction.TransactionService/CreateTransfer"
+	TransactionService_CreateRecurringTransaction_FullMethodName  = "/transaction.TransactionService/CreateRecurringTransaction"
+	TransactionService_GetRecurringTransactions_FullMethodName    = "/transaction.TransactionService/GetRecurringTransactions"
+	TransactionService_GetRecurringTransactionById_FullMethodName = "/transaction.TransactionService/GetRecurringTransactionById"
+	TransactionService_UpdateRecurringTransaction_FullMethodName  = "/transaction.TransactionService/UpdateRecurringTransaction"
+	TransactionService_DeleteRecurringTransaction_FullMethodName  = "/transaction.TransactionService/DeleteRecurringTransaction"
+	TransactionService_ImportTransactions_FullMethodName          = "/transaction.TransactionService/ImportTransactions"
+	TransactionService_ImportStatement_FullMethodName             = "/transaction.TransactionService/ImportStatement"
+	TransactionService_ExportTransactions_FullMethodName          = "/transaction.TransactionService/ExportTransactions"
+	TransactionService_CreateRule_FullMethodName                  = "/transaction.TransactionService/CreateRule"
+	TransactionService_GetRules_FullMethodName                    = "/transaction.TransactionService/GetRules"
+	TransactionService_GetRuleById_FullMethodName                 = "/transaction.TransactionService/GetRuleById"
+	TransactionService_UpdateRule_FullMethodName                  = "/transaction.TransactionService/UpdateRule"
+	TransactionService_DeleteRule_FullMethodName                  = "/transaction.TransactionService/DeleteRule"
+	TransactionService_PreviewRules_FullMethodName                = "/transaction.TransactionService/PreviewRules"
+	TransactionService_ApplyRules_FullMethodName                  = "/transaction.TransactionService/ApplyRules"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -35,6 +51,22 @@ type TransactionServiceClient interface {
 	GetTransactionById(ctx context.Context, in *GetTransactionByIdRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error)
+	GetRecurringTransactions(ctx context.Context, in *GetRecurringTransactionsRequest, opts ...grpc.CallOption) (*RecurringTransactionsResponse, error)
+	GetRecurringTransactionById(ctx context.Context, in *GetRecurringTransactionByIdRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error)
+	UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error)
+	DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*Empty, error)
+	ImportTransactions(ctx context.Context, in *ImportTransactionsRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error)
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error)
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (TransactionService_ExportTransactionsClient, error)
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error)
+	GetRuleById(ctx context.Context, in *GetRuleByIdRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*Empty, error)
+	PreviewRules(ctx context.Context, in *PreviewRulesRequest, opts ...grpc.CallOption) (*PreviewRulesResponse, error)
+	ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -95,6 +127,189 @@ func (c *transactionServiceClient) DeleteTransaction(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *transactionServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetRecurringTransactions(ctx context.Context, in *GetRecurringTransactionsRequest, opts ...grpc.CallOption) (*RecurringTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetRecurringTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetRecurringTransactionById(ctx context.Context, in *GetRecurringTransactionByIdRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetRecurringTransactionById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionRequest, opts ...grpc.CallOption) (*RecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ImportTransactions(ctx context.Context, in *ImportTransactionsRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ImportTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (TransactionService_ExportTransactionsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_ExportTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionServiceExportTransactionsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionService_ExportTransactionsClient interface {
+	Recv() (*ExportTransactionsChunk, error)
+	grpc.ClientStream
+}
+
+type transactionServiceExportTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionServiceExportTransactionsClient) Recv() (*ExportTransactionsChunk, error) {
+	m := new(ExportTransactionsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transactionServiceClient) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetRuleById(ctx context.Context, in *GetRuleByIdRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetRuleById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) PreviewRules(ctx context.Context, in *PreviewRulesRequest, opts ...grpc.CallOption) (*PreviewRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRulesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_PreviewRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyRulesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ApplyRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility
@@ -104,6 +319,22 @@ type TransactionServiceServer interface {
 	GetTransactionById(context.Context, *GetTransactionByIdRequest) (*TransactionResponse, error)
 	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*TransactionResponse, error)
 	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*Empty, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*TransferResponse, error)
+	CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*RecurringTransactionResponse, error)
+	GetRecurringTransactions(context.Context, *GetRecurringTransactionsRequest) (*RecurringTransactionsResponse, error)
+	GetRecurringTransactionById(context.Context, *GetRecurringTransactionByIdRequest) (*RecurringTransactionResponse, error)
+	UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionRequest) (*RecurringTransactionResponse, error)
+	DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*Empty, error)
+	ImportTransactions(context.Context, *ImportTransactionsRequest) (*ImportTransactionsResponse, error)
+	ImportStatement(context.Context, *ImportStatementRequest) (*ImportTransactionsResponse, error)
+	ExportTransactions(*ExportTransactionsRequest, TransactionService_ExportTransactionsServer) error
+	CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error)
+	GetRules(context.Context, *GetRulesRequest) (*RulesResponse, error)
+	GetRuleById(context.Context, *GetRuleByIdRequest) (*RuleResponse, error)
+	UpdateRule(context.Context, *UpdateRuleRequest) (*RuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*Empty, error)
+	PreviewRules(context.Context, *PreviewRulesRequest) (*PreviewRulesResponse, error)
+	ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -126,6 +357,54 @@ func (UnimplementedTransactionServiceServer) UpdateTransaction(context.Context,
 func (UnimplementedTransactionServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*RecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetRecurringTransactions(context.Context, *GetRecurringTransactionsRequest) (*RecurringTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetRecurringTransactionById(context.Context, *GetRecurringTransactionByIdRequest) (*RecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringTransactionById not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionRequest) (*RecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ImportTransactions(context.Context, *ImportTransactionsRequest) (*ImportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ImportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedTransactionServiceServer) ExportTransactions(*ExportTransactionsRequest, TransactionService_ExportTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetRules(context.Context, *GetRulesRequest) (*RulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRules not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetRuleById(context.Context, *GetRuleByIdRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleById not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateRule(context.Context, *UpdateRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedTransactionServiceServer) PreviewRules(context.Context, *PreviewRulesRequest) (*PreviewRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRules not implemented")
+}
+func (UnimplementedTransactionServiceServer) ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRules not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 
 // UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +508,297 @@ func _TransactionService_DeleteTransaction_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateRecurringTransaction(ctx, req.(*CreateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetRecurringTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetRecurringTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetRecurringTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetRecurringTransactions(ctx, req.(*GetRecurringTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetRecurringTransactionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringTransactionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetRecurringTransactionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetRecurringTransactionById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetRecurringTransactionById(ctx, req.(*GetRecurringTransactionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateRecurringTransaction(ctx, req.(*UpdateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteRecurringTransaction(ctx, req.(*DeleteRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ImportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ImportTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ImportTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ImportTransactions(ctx, req.(*ImportTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ImportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).ExportTransactions(m, &transactionServiceExportTransactionsServer{ServerStream: stream})
+}
+
+type TransactionService_ExportTransactionsServer interface {
+	Send(*ExportTransactionsChunk) error
+	grpc.ServerStream
+}
+
+type transactionServiceExportTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionServiceExportTransactionsServer) Send(m *ExportTransactionsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransactionService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetRules(ctx, req.(*GetRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetRuleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetRuleById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetRuleById(ctx, req.(*GetRuleByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_PreviewRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PreviewRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_PreviewRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PreviewRules(ctx, req.(*PreviewRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ApplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ApplyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ApplyRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ApplyRules(ctx, req.(*ApplyRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,7 +826,73 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTransaction",
 			Handler:    _TransactionService_DeleteTransaction_Handler,
 		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _TransactionService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "CreateRecurringTransaction",
+			Handler:    _TransactionService_CreateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "GetRecurringTransactions",
+			Handler:    _TransactionService_GetRecurringTransactions_Handler,
+		},
+		{
+			MethodName: "GetRecurringTransactionById",
+			Handler:    _TransactionService_GetRecurringTransactionById_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringTransaction",
+			Handler:    _TransactionService_UpdateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringTransaction",
+			Handler:    _TransactionService_DeleteRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ImportTransactions",
+			Handler:    _TransactionService_ImportTransactions_Handler,
+		},
+		{
+			MethodName: "ImportStatement",
+			Handler:    _TransactionService_ImportStatement_Handler,
+		},
+		{
+			MethodName: "CreateRule",
+			Handler:    _TransactionService_CreateRule_Handler,
+		},
+		{
+			MethodName: "GetRules",
+			Handler:    _TransactionService_GetRules_Handler,
+		},
+		{
+			MethodName: "GetRuleById",
+			Handler:    _TransactionService_GetRuleById_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _TransactionService_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _TransactionService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "PreviewRules",
+			Handler:    _TransactionService_PreviewRules_Handler,
+		},
+		{
+			MethodName: "ApplyRules",
+			Handler:    _TransactionService_ApplyRules_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTransactions",
+			Handler:       _TransactionService_ExportTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction-service/transaction-service.proto",
 }
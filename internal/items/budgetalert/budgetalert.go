@@ -0,0 +1,94 @@
+package budgetalert
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"budgeting-service/internal/items/money"
+)
+
+// OverBudget is the threshold that fires once spending exceeds the budget.
+const OverBudget = "over"
+
+// DefaultThresholds are used for a budget created without alert settings.
+var DefaultThresholds = []int32{50, 80, 100}
+
+// Thresholds checks percentages of a budget to alert at and returns them
+// sorted without duplicates.
+func Thresholds(percents []int32) ([]int32, error) {
+	thresholds := make([]int32, 0, len(percents))
+	seen := make(map[int32]bool)
+	for _, percent := range percents {
+		if percent <= 0 || percent > 1000 {
+			return nil, fmt.Errorf("alert threshold %d%% must be between 1%% and 1000%%", percent)
+		}
+		if seen[percent] {
+			continue
+		}
+		seen[percent] = true
+		thresholds = append(thresholds, percent)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+	return thresholds, nil
+}
+
+// Crossed returns the thresholds spending has reached, lowest first, with
+// OverBudget last when spending is above the limit.
+func Crossed(spent, limit int64, percents []int32, overBudget bool) []string {
+	if limit <= 0 {
+		return nil
+	}
+
+	var crossed []string
+	for _, percent := range percents {
+		if spent*100 >= limit*int64(percent) {
+			crossed = append(crossed, strconv.Itoa(int(percent)))
+		}
+	}
+	if overBudget && spent > limit {
+		crossed = append(crossed, OverBudget)
+	}
+	return crossed
+}
+
+// Alert is a threshold of a budget crossed in one period.
+type Alert struct {
+	BudgetID     string
+	UserID       string
+	CategoryName string
+	Threshold    string
+	Spent        int64
+	Limit        int64
+	Currency     string
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+}
+
+// Key identifies the alert within its budget, so it fires once per period.
+func Key(periodStart time.Time, threshold string) string {
+	return periodStart.Format("2006-01-02") + ":" + threshold
+}
+
+// Message is the notification text for the alert.
+func (a Alert) Message() string {
+	category := a.CategoryName
+	if category == "" {
+		category = "uncategorized"
+	}
+	period := a.PeriodStart.Format("2006-01-02") + " to " + a.PeriodEnd.Format("2006-01-02")
+	spent := a.amount(a.Spent) + " of " + a.amount(a.Limit)
+
+	if a.Threshold == OverBudget {
+		return fmt.Sprintf("You are %s over your %s budget (%s) for %s.", a.amount(a.Spent-a.Limit), category, spent, period)
+	}
+	return fmt.Sprintf("You have reached %s%% of your %s budget (%s) for %s.", a.Threshold, category, spent, period)
+}
+
+func (a Alert) amount(cents int64) string {
+	if a.Currency == "" {
+		return money.Format(cents)
+	}
+	return money.Format(cents) + " " + a.Currency
+}
@@ -0,0 +1,90 @@
+package budgetperiod
+
+import (
+	"fmt"
+	"time"
+
+	"budgeting-service/internal/items/recurrence"
+)
+
+const (
+	Weekly    = recurrence.Weekly
+	Biweekly  = recurrence.Biweekly
+	Monthly   = recurrence.Monthly
+	Quarterly = "quarterly"
+	Yearly    = recurrence.Yearly
+	// Custom budgets cover the dates they are given once and do not renew.
+	Custom = "custom"
+)
+
+// Periods are all the periods a budget can have.
+var Periods = []string{Weekly, Biweekly, Monthly, Quarterly, Yearly, Custom}
+
+// Rollover modes decide what part of a period's remaining money is carried
+// into the limit of the next one.
+const (
+	RolloverNone      = "none"
+	RolloverUnspent   = "unspent"
+	RolloverOverspent = "overspent"
+	RolloverBoth      = "both"
+)
+
+// Recurring reports whether budgets with the period renew themselves.
+func Recurring(period string) bool {
+	_, ok := rule(period, time.Time{})
+	return ok
+}
+
+func rule(period string, anchor time.Time) (recurrence.Rule, bool) {
+	switch period {
+	case Weekly, Biweekly, Monthly, Yearly:
+		return recurrence.Rule{Frequency: period, Start: anchor}, true
+	case Quarterly:
+		return recurrence.Rule{Frequency: recurrence.Monthly, Interval: 3, Start: anchor}, true
+	}
+	return recurrence.Rule{}, false
+}
+
+// Next returns the period that follows one ending on end. Periods are counted
+// from anchor, the start of the budget's first period, so a monthly budget
+// started on the 31st keeps starting on the last day of each month instead of
+// drifting to the 28th after February.
+func Next(period string, anchor, end time.Time) (time.Time, time.Time, bool) {
+	r, ok := rule(period, anchor)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := end.AddDate(0, 0, 1)
+	return start, r.Next(start).AddDate(0, 0, -1), true
+}
+
+// Rollover checks a rollover mode. An empty mode means none.
+func Rollover(mode, period string) (string, error) {
+	switch mode {
+	case "", RolloverNone:
+		return RolloverNone, nil
+	case RolloverUnspent, RolloverOverspent, RolloverBoth:
+	default:
+		return "", fmt.Errorf("unknown rollover mode %q", mode)
+	}
+	if !Recurring(period) {
+		return "", fmt.Errorf("rollover needs a recurring period, got %q", period)
+	}
+	return mode, nil
+}
+
+// Carry returns what a closed period adds to the next period's limit: the
+// money left over as a positive amount, or the overspending as a negative one.
+func Carry(mode string, limit, spent int64) int64 {
+	remaining := limit - spent
+	switch {
+	case mode == RolloverBoth:
+		return remaining
+	case mode == RolloverUnspent && remaining > 0:
+		return remaining
+	case mode == RolloverOverspent && remaining < 0:
+		return remaining
+	}
+	return 0
+}
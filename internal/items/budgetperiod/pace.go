@@ -0,0 +1,64 @@
+package budgetperiod
+
+import (
+	"math"
+	"time"
+)
+
+// Pace describes how fast a budget is being spent. Amounts are in minor units.
+type Pace struct {
+	DaysTotal   int
+	DaysElapsed int
+	Remaining   int64
+	PercentUsed float64
+	DailyBurn   int64
+	Projected   int64
+}
+
+// Measure works out the pace of spending spent against limit over the period
+// from start to end, both inclusive, as of asOf. Only the day of asOf counts,
+// so the day under way is elapsed from its first moment. The projection
+// assumes the rest of the period is spent at the same daily rate as the days
+// so far.
+func Measure(limit, spent int64, start, end, asOf time.Time) Pace {
+	pace := Pace{
+		DaysTotal: days(start, end),
+		Remaining: limit - spent,
+		Projected: spent,
+	}
+
+	asOf = asOf.UTC()
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	if asOf.After(end) {
+		asOf = end
+	}
+	if !asOf.Before(start) {
+		pace.DaysElapsed = days(start, asOf)
+	}
+
+	if limit > 0 {
+		pace.PercentUsed = math.Round(float64(spent)*10000/float64(limit)) / 100
+	}
+	if pace.DaysElapsed > 0 {
+		pace.DailyBurn = divRound(spent, int64(pace.DaysElapsed))
+		pace.Projected = divRound(spent*int64(pace.DaysTotal), int64(pace.DaysElapsed))
+	}
+
+	return pace
+}
+
+// days counts the days from start to end, both inclusive.
+func days(start, end time.Time) int {
+	if end.Before(start) {
+		return 0
+	}
+	return int(math.Round(end.Sub(start).Hours()/24)) + 1
+}
+
+// divRound divides, rounding half away from zero.
+func divRound(a, b int64) int64 {
+	if (a < 0) != (b < 0) {
+		return (a - b/2) / b
+	}
+	return (a + b/2) / b
+}
@@ -0,0 +1,121 @@
+package categoryseed
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultLocale is the locale every template has to be named in, and the one
+// used when a template has no name in the locale asked for.
+const DefaultLocale = "en"
+
+//go:embed defaults.json
+var defaults []byte
+
+// Set is a versioned list of default categories. Whenever categories are
+// added to it its version goes up, and the new templates carry that version
+// in Since, so users who already got an earlier version can be given just the
+// new ones.
+type Set struct {
+	Version    int        `json:"version"`
+	Categories []Template `json:"categories"`
+}
+
+// Template is one default category. Parent is the key of the template it sits
+// under, which has to come earlier in the set.
+type Template struct {
+	Key    string            `json:"key"`
+	Parent string            `json:"parent"`
+	Type   string            `json:"type"`
+	Since  int               `json:"since"`
+	Names  map[string]string `json:"names"`
+}
+
+// Default returns the set built into the service.
+func Default() *Set {
+	set, err := Parse(bytes.NewReader(defaults))
+	if err != nil {
+		panic("categoryseed: invalid built-in defaults: " + err.Error())
+	}
+	return set
+}
+
+// Load reads a set from a JSON file, or returns the built-in one when path is
+// empty.
+func Load(path string) (*Set, error) {
+	if path == "" {
+		return Default(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse reads and validates a set.
+func Parse(r io.Reader) (*Set, error) {
+	var set Set
+	if err := json.NewDecoder(r).Decode(&set); err != nil {
+		return nil, err
+	}
+	if set.Version < 1 {
+		return nil, fmt.Errorf("invalid version %d", set.Version)
+	}
+
+	types := make(map[string]string, len(set.Categories))
+	for i, template := range set.Categories {
+		switch {
+		case template.Key == "":
+			return nil, fmt.Errorf("category %d: key is required", i+1)
+		case types[template.Key] != "":
+			return nil, fmt.Errorf("category %q: duplicate key", template.Key)
+		case template.Type != "income" && template.Type != "expense":
+			return nil, fmt.Errorf("category %q: invalid type %q", template.Key, template.Type)
+		case template.Since < 1 || template.Since > set.Version:
+			return nil, fmt.Errorf("category %q: since must be between 1 and %d", template.Key, set.Version)
+		case template.Names[DefaultLocale] == "":
+			return nil, fmt.Errorf("category %q: %s name is required", template.Key, DefaultLocale)
+		}
+		if template.Parent != "" && types[template.Parent] != template.Type {
+			return nil, fmt.Errorf("category %q: parent %q must be an earlier %s category", template.Key, template.Parent, template.Type)
+		}
+		types[template.Key] = template.Type
+	}
+
+	return &set, nil
+}
+
+// Since returns the templates added after version, in order.
+func (s *Set) Since(version int) []Template {
+	var templates []Template
+	for _, template := range s.Categories {
+		if template.Since > version {
+			templates = append(templates, template)
+		}
+	}
+	return templates
+}
+
+// Name returns the template's name in locale, falling back from a regional
+// locale such as "ru-RU" to its language and then to DefaultLocale.
+func (t Template) Name(locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if name := t.Names[locale]; name != "" {
+		return name
+	}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		if name := t.Names[language]; name != "" {
+			return name
+		}
+	}
+	return t.Names[DefaultLocale]
+}
@@ -0,0 +1,113 @@
+package categorytree
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	errCycle            = errors.New("a category cannot be moved under itself or one of its subcategories")
+	errMergeIntoSubtree = errors.New("a category cannot be merged into itself or one of its subcategories")
+)
+
+// Tree is the parent structure of a user's categories, by category id. A
+// category without a parent is at the top level.
+type Tree struct {
+	parents  map[string]string
+	children map[string][]string
+}
+
+// New builds a tree from each category's parent id, "" for the top level.
+func New(parents map[string]string) *Tree {
+	t := &Tree{parents: parents, children: make(map[string][]string)}
+	for id, parent := range parents {
+		if parent != "" {
+			t.children[parent] = append(t.children[parent], id)
+		}
+	}
+	for _, children := range t.children {
+		sort.Strings(children)
+	}
+	return t
+}
+
+// Children returns the ids of the direct subcategories of id, or of the top
+// level categories when id is "".
+func (t *Tree) Children(id string) []string {
+	if id != "" {
+		return t.children[id]
+	}
+
+	var roots []string
+	for category, parent := range t.parents {
+		if parent == "" || !t.has(parent) {
+			roots = append(roots, category)
+		}
+	}
+	sort.Strings(roots)
+	return roots
+}
+
+// Descendants returns id followed by every category below it.
+func (t *Tree) Descendants(id string) []string {
+	ids := []string{id}
+	seen := map[string]bool{id: true}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range t.children[ids[i]] {
+			if !seen[child] {
+				seen[child] = true
+				ids = append(ids, child)
+			}
+		}
+	}
+	return ids
+}
+
+// Ancestors returns id followed by its parent, its parent's parent and so on
+// up to the top level. A parent missing from the tree ends the chain, as in
+// Children.
+func (t *Tree) Ancestors(id string) []string {
+	ids := []string{id}
+	seen := map[string]bool{id: true}
+	for parent := t.parents[id]; t.has(parent) && !seen[parent]; parent = t.parents[parent] {
+		seen[parent] = true
+		ids = append(ids, parent)
+	}
+	return ids
+}
+
+// Root returns the top level category id sits under, or id itself.
+func (t *Tree) Root(id string) string {
+	ancestors := t.Ancestors(id)
+	return ancestors[len(ancestors)-1]
+}
+
+// CheckMove reports whether id may be moved under parent without making a
+// cycle. An empty parent moves it to the top level.
+func (t *Tree) CheckMove(id, parent string) error {
+	if parent == "" {
+		return nil
+	}
+	for _, ancestor := range t.Ancestors(parent) {
+		if ancestor == id {
+			return errCycle
+		}
+	}
+	return nil
+}
+
+// CheckMerge reports whether source may be merged into target, which must not
+// be source itself or one of its subcategories.
+func (t *Tree) CheckMerge(source, target string) error {
+	for _, ancestor := range t.Ancestors(target) {
+		if ancestor == source {
+			return errMergeIntoSubtree
+		}
+	}
+	return nil
+}
+
+func (t *Tree) has(id string) bool {
+	_, ok := t.parents[id]
+	return ok
+}
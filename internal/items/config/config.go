@@ -8,10 +8,13 @@ import (
 
 type (
 	Config struct {
-		Server  ServerConfig
-		MongoDb MongoDbConfig
-		JWT     JWTConfig
-		Kafka   KafkaConfig
+		Server     ServerConfig
+		MongoDb    MongoDbConfig
+		JWT        JWTConfig
+		Kafka      KafkaConfig
+		Casbin     CasbinConfig
+		Currency   CurrencyConfig
+		Categories CategoriesConfig
 	}
 	JWTConfig struct {
 		SecretKey string
@@ -30,6 +33,18 @@ type (
 	KafkaConfig struct {
 		Broker string
 	}
+	CasbinConfig struct {
+		ModelPath  string
+		PolicyPath string
+	}
+	CurrencyConfig struct {
+		DefaultBase string
+		RatesFile   string
+	}
+	CategoriesConfig struct {
+		TemplatesFile string
+		DefaultLocale string
+	}
 )
 
 func (c *Config) Load() error {
@@ -45,10 +60,23 @@ func (c *Config) Load() error {
 	c.MongoDb.DBName = os.Getenv("DB_NAME")
 	c.JWT.SecretKey = os.Getenv("JWT_SECRET_KEY")
 	c.Kafka.Broker = os.Getenv("KAFKA_BROKER_URI")
+	c.Casbin.ModelPath = getEnv("CASBIN_MODEL_PATH", "internal/casbin/rbac_model.conf")
+	c.Casbin.PolicyPath = getEnv("CASBIN_POLICY_PATH", "internal/casbin/policy.csv")
+	c.Currency.DefaultBase = getEnv("DEFAULT_BASE_CURRENCY", "USD")
+	c.Currency.RatesFile = os.Getenv("EXCHANGE_RATES_FILE")
+	c.Categories.TemplatesFile = os.Getenv("CATEGORY_TEMPLATES_FILE")
+	c.Categories.DefaultLocale = getEnv("DEFAULT_LOCALE", "en")
 
 	return nil
 }
 
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
 func New() (*Config, error) {
 	var config Config
 	if err := config.Load(); err != nil {
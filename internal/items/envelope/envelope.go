@@ -0,0 +1,106 @@
+package envelope
+
+import (
+	"time"
+
+	"budgeting-service/internal/items/money"
+	"budgeting-service/internal/items/validation"
+)
+
+// Month parses a month as YYYY-MM into its first day, defaulting to the month
+// of now.
+func Month(month string, now time.Time) (time.Time, error) {
+	if month == "" {
+		now = now.UTC()
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+	t, err := time.Parse("2006-01", month)
+	if err != nil {
+		return time.Time{}, validation.Field("month", "%q is not a month in the form YYYY-MM", month)
+	}
+	return t, nil
+}
+
+// Assignment is money assigned to a category's envelope for a month, in the
+// currency envelopes are kept in.
+type Assignment struct {
+	CategoryID string
+	Month      time.Time
+	Amount     int64
+}
+
+// Envelope is what a category's envelope has been given as of a month.
+type Envelope struct {
+	CategoryID string
+	// FirstMonth is the first month anything was assigned to the category.
+	FirstMonth time.Time
+	// Assigned is what was assigned in the month itself, AssignedTotal what
+	// was assigned in every month up to and including it.
+	Assigned      int64
+	AssignedTotal int64
+}
+
+// Available is what is left in the envelope once spent, the spending in the
+// category from the envelope's first month to the end of the month, is taken
+// out of it.
+func (e Envelope) Available(spent int64) int64 {
+	return e.AssignedTotal - spent
+}
+
+// Summary is every envelope of a user as of a month.
+type Summary struct {
+	// FirstMonth is the user's first envelope month, or the month itself if
+	// nothing was assigned before it.
+	FirstMonth    time.Time
+	Assigned      int64
+	AssignedTotal int64
+	// Envelopes are in the order their categories were first assigned to.
+	Envelopes []Envelope
+}
+
+// ToBeBudgeted is the income from the first envelope month to the end of the
+// month that has not been assigned to any envelope.
+func (s Summary) ToBeBudgeted(income int64) int64 {
+	return income - s.AssignedTotal
+}
+
+// Summarize adds up assignments, given in month order, as of month.
+// Assignments to later months are left out.
+func Summarize(assignments []Assignment, month time.Time) Summary {
+	summary := Summary{FirstMonth: month}
+	index := make(map[string]int)
+
+	for _, a := range assignments {
+		if a.Month.After(month) {
+			continue
+		}
+
+		i, ok := index[a.CategoryID]
+		if !ok {
+			i = len(summary.Envelopes)
+			index[a.CategoryID] = i
+			summary.Envelopes = append(summary.Envelopes, Envelope{CategoryID: a.CategoryID, FirstMonth: a.Month})
+		}
+		if a.Month.Before(summary.FirstMonth) {
+			summary.FirstMonth = a.Month
+		}
+
+		e := &summary.Envelopes[i]
+		e.AssignedTotal += a.Amount
+		summary.AssignedTotal += a.Amount
+		if a.Month.Equal(month) {
+			e.Assigned += a.Amount
+			summary.Assigned += a.Amount
+		}
+	}
+
+	return summary
+}
+
+// CheckMove refuses to take more out of an envelope than it has available.
+func CheckMove(available, amount int64, currency string) error {
+	if amount > available {
+		return validation.Field("amount", "only %s %s is available in the from_category_id envelope", money.Format(available), currency)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"budgeting-service/internal/items/money"
+)
+
+var ErrNoRate = errors.New("no exchange rate")
+
+// Rate is the price of one unit of Base in Quote on Date.
+type Rate struct {
+	Base  string
+	Quote string
+	Date  time.Time
+	Rate  float64
+}
+
+func (r Rate) Validate() error {
+	if _, err := Currency(r.Base); err != nil {
+		return err
+	}
+	if _, err := Currency(r.Quote); err != nil {
+		return err
+	}
+	if strings.EqualFold(r.Base, r.Quote) {
+		return fmt.Errorf("rate from %s to itself", r.Base)
+	}
+	if r.Date.IsZero() {
+		return errors.New("rate date is required")
+	}
+	if !(r.Rate > 0) {
+		return fmt.Errorf("rate %v for %s/%s must be positive", r.Rate, r.Base, r.Quote)
+	}
+	return nil
+}
+
+// Provider supplies exchange rates from an outside source, such as a file or
+// a bank's API. Whatever it returns is stored and used for later lookups.
+type Provider interface {
+	Name() string
+	Rates(ctx context.Context) ([]Rate, error)
+}
+
+// Currency checks and upper-cases an ISO 4217 code.
+func Currency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", fmt.Errorf("invalid currency code %q", code)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("invalid currency code %q", code)
+		}
+	}
+	return code, nil
+}
+
+// Lookup returns the rate to convert from one currency to another as of date.
+type Lookup func(ctx context.Context, from, to string, date time.Time) (float64, error)
+
+type rateKey struct {
+	from string
+	day  string
+}
+
+// Converter converts amounts into one currency, looking each rate up once per
+// source currency and day.
+type Converter struct {
+	currency string
+	lookup   Lookup
+	rates    map[rateKey]float64
+}
+
+func NewConverter(currency string, lookup Lookup) *Converter {
+	return &Converter{
+		currency: currency,
+		lookup:   lookup,
+		rates:    make(map[rateKey]float64),
+	}
+}
+
+func (c *Converter) Currency() string {
+	return c.currency
+}
+
+// Convert turns cents in from into the converter's currency at the rate for
+// date. Amounts without a currency are taken to be in it already, and a
+// converter without a currency leaves every amount as it is.
+func (c *Converter) Convert(ctx context.Context, cents int64, from string, date time.Time) (int64, error) {
+	if from == "" || c.currency == "" || strings.EqualFold(from, c.currency) || cents == 0 {
+		return cents, nil
+	}
+
+	key := rateKey{from: strings.ToUpper(from), day: date.UTC().Format("2006-01-02")}
+	rate, ok := c.rates[key]
+	if !ok {
+		var err error
+		rate, err = c.lookup(ctx, key.from, c.currency, date)
+		if err != nil {
+			return 0, err
+		}
+		c.rates[key] = rate
+	}
+
+	return money.Convert(cents, rate), nil
+}
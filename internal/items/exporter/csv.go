@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+var csvHeader = []string{"id", "date", "account", "currency", "type", "category", "description", "amount", "transfer_id"}
+
+// csvWriter writes one line per transaction, or one line per split for split
+// transactions so every line carries a single category and the amounts still
+// add up in a spreadsheet. Amounts are signed by their effect on the balance.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	writer := &csvWriter{w: csv.NewWriter(w)}
+	if err := writer.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (c *csvWriter) Write(t *Transaction) error {
+	line := func(category string, amount Amount) []string {
+		return []string{
+			t.ID,
+			t.Date.Format("2006-01-02"),
+			t.AccountName,
+			t.Currency,
+			t.Type,
+			category,
+			t.Description,
+			signedAmount(t.Type, amount).String(),
+			t.TransferID,
+		}
+	}
+
+	if len(t.Splits) == 0 {
+		return c.w.Write(line(t.CategoryName, t.Amount))
+	}
+	for _, split := range t.Splits {
+		if err := c.w.Write(line(split.CategoryName, split.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,88 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"budgeting-service/internal/items/money"
+)
+
+const (
+	CSV    = "csv"
+	NDJSON = "ndjson"
+	OFX    = "ofx"
+)
+
+// Transaction is a stored transaction with its account and category resolved
+// to names, ready to be written out. Amount is positive; Type gives its
+// direction, as in storage.
+type Transaction struct {
+	ID           string    `json:"id"`
+	Date         time.Time `json:"date"`
+	AccountID    string    `json:"account_id"`
+	AccountName  string    `json:"account_name"`
+	Currency     string    `json:"currency"`
+	CategoryID   string    `json:"category_id,omitempty"`
+	CategoryName string    `json:"category_name,omitempty"`
+	Type         string    `json:"type"`
+	Amount       Amount    `json:"amount"`
+	Description  string    `json:"description"`
+	TransferID   string    `json:"transfer_id,omitempty"`
+	ExternalID   string    `json:"external_id,omitempty"`
+	Splits       []Split   `json:"splits,omitempty"`
+}
+
+type Split struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Amount       Amount `json:"amount"`
+}
+
+// Amount is a sum in minor units. It is written as a plain decimal with two
+// places, in JSON too, so exports never show binary rounding noise.
+type Amount int64
+
+func (a Amount) String() string {
+	return money.Format(int64(a))
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// Writer writes transactions one at a time, so an export never holds more
+// than one of them in memory. Close writes whatever the format needs after
+// the last transaction; it does not close the underlying writer.
+type Writer interface {
+	Write(t *Transaction) error
+	Close() error
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch strings.ToLower(format) {
+	case CSV:
+		return newCSVWriter(w)
+	case NDJSON, "json":
+		return newNDJSONWriter(w), nil
+	case OFX:
+		return newOFXWriter(w), nil
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// GroupsByAccount reports whether a format needs the transactions of each
+// account to arrive together. OFX wraps every account in its own statement.
+func GroupsByAccount(format string) bool {
+	return strings.ToLower(format) == OFX
+}
+
+// signedAmount returns the amount as it moves the account balance.
+func signedAmount(transactionType string, amount Amount) Amount {
+	switch transactionType {
+	case "expense", "transfer_out":
+		return -amount
+	}
+	return amount
+}
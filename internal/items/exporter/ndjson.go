@@ -0,0 +1,29 @@
+package exporter
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type ndjsonTransaction struct {
+	*Transaction
+	Date string `json:"date"`
+}
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	return &ndjsonWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write encodes one transaction per line, with a date-only date like the rest
+// of the API.
+func (n *ndjsonWriter) Write(t *Transaction) error {
+	return n.encoder.Encode(ndjsonTransaction{Transaction: t, Date: t.Date.Format("2006-01-02")})
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
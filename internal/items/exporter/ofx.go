@@ -0,0 +1,133 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS><DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+`
+
+// ofxNameLength is the longest NAME the OFX spec allows.
+const ofxNameLength = 32
+
+// ofxWriter writes an OFX 2.2 file with one statement per account. It relies
+// on the transactions of each account arriving together, see GroupsByAccount.
+type ofxWriter struct {
+	w       *bufio.Writer
+	started bool
+	account string
+	now     time.Time
+	err     error
+}
+
+func newOFXWriter(w io.Writer) *ofxWriter {
+	return &ofxWriter{w: bufio.NewWriter(w), now: time.Now().UTC()}
+}
+
+func (o *ofxWriter) Write(t *Transaction) error {
+	if !o.started {
+		o.printf(ofxHeader, o.now.Format("20060102150405"))
+		o.started = true
+	}
+
+	if t.AccountID != o.account {
+		if o.account != "" {
+			o.closeStatement()
+		}
+		o.account = t.AccountID
+		o.printf("<STMTTRNRS><TRNUID>0</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+		o.printf("<STMTRS><CURDEF>%s</CURDEF>\n", escape(t.Currency))
+		o.printf("<BANKACCTFROM><BANKID>0</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n", escape(t.AccountID))
+		o.printf("<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", t.Date.Format("20060102"), o.now.Format("20060102"))
+	}
+
+	trnType := "CREDIT"
+	switch t.Type {
+	case "expense":
+		trnType = "DEBIT"
+	case "transfer_in", "transfer_out":
+		trnType = "XFER"
+	}
+
+	fitID := t.ExternalID
+	if fitID == "" {
+		fitID = t.ID
+	}
+
+	name := []rune(t.Description)
+	if len(name) > ofxNameLength {
+		name = name[:ofxNameLength]
+	}
+
+	o.printf("<STMTTRN><TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%s</TRNAMT><FITID>%s</FITID>",
+		trnType,
+		t.Date.Format("20060102"),
+		signedAmount(t.Type, t.Amount).String(),
+		escape(fitID),
+	)
+	if len(name) > 0 {
+		o.printf("<NAME>%s</NAME>", escape(string(name)))
+	}
+	if memo := ofxMemo(t); memo != "" {
+		o.printf("<MEMO>%s</MEMO>", escape(memo))
+	}
+	o.printf("</STMTTRN>\n")
+
+	return o.err
+}
+
+func (o *ofxWriter) Close() error {
+	if !o.started {
+		o.printf(ofxHeader, o.now.Format("20060102150405"))
+	}
+	if o.account != "" {
+		o.closeStatement()
+	}
+	o.printf("</BANKMSGSRSV1>\n</OFX>\n")
+
+	if o.err != nil {
+		return o.err
+	}
+	return o.w.Flush()
+}
+
+func (o *ofxWriter) closeStatement() {
+	o.printf("</BANKTRANLIST></STMTRS></STMTTRNRS>\n")
+}
+
+// printf remembers the first write error, so Write and Close can report it
+// once instead of checking every line.
+func (o *ofxWriter) printf(format string, args ...interface{}) {
+	if o.err != nil {
+		return
+	}
+	_, o.err = fmt.Fprintf(o.w, format, args...)
+}
+
+// ofxMemo names the categories of a transaction, since OFX has no field for
+// them.
+func ofxMemo(t *Transaction) string {
+	if len(t.Splits) == 0 {
+		return t.CategoryName
+	}
+	names := make([]string, 0, len(t.Splits))
+	for _, split := range t.Splits {
+		names = append(names, split.CategoryName)
+	}
+	return strings.Join(names, ", ")
+}
+
+func escape(value string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
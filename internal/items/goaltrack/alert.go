@@ -0,0 +1,67 @@
+package goaltrack
+
+import (
+	"fmt"
+	"time"
+
+	"budgeting-service/internal/items/money"
+)
+
+// Alert kinds.
+const (
+	AlertAchieved  = "achieved"
+	AlertMilestone = "milestone"
+	AlertAtRisk    = "at_risk"
+)
+
+// Alert tells a user about something that happened to one of their goals.
+type Alert struct {
+	Kind     string
+	GoalID   string
+	UserID   string
+	Name     string
+	Current  int64
+	Target   int64
+	Deadline time.Time
+	Currency string
+	// Milestone is set for AlertMilestone.
+	Milestone Milestone
+	// Projection is set for AlertAtRisk.
+	Projection Projection
+}
+
+// Message is the notification text for the alert.
+func (a Alert) Message() string {
+	switch a.Kind {
+	case AlertMilestone:
+		milestone := a.amount(a.Milestone.Amount)
+		if a.Milestone.Percent > 0 {
+			milestone = fmt.Sprintf("%d%%", a.Milestone.Percent)
+		}
+		return fmt.Sprintf("Your goal %s has reached its %s milestone (%s of %s).", a.Name, milestone, a.amount(a.Current), a.amount(a.Target))
+	case AlertAtRisk:
+		return fmt.Sprintf("Your goal %s is at risk of missing its %s deadline: it needs %s a month but has been growing by %s a month.",
+			a.Name, a.Deadline.Format("2006-01-02"), a.amount(a.Projection.MonthlyRequired), a.amount(a.Projection.MonthlyRate))
+	}
+	return fmt.Sprintf("Congratulations, you have reached your goal %s!", a.Name)
+}
+
+// MilestoneAlerts makes one milestone alert about the goal of a for each of
+// milestones, so reaching several at once tells the user about every one.
+func (a Alert) MilestoneAlerts(milestones []Milestone) []*Alert {
+	alerts := make([]*Alert, 0, len(milestones))
+	for _, milestone := range milestones {
+		alert := a
+		alert.Kind = AlertMilestone
+		alert.Milestone = milestone
+		alerts = append(alerts, &alert)
+	}
+	return alerts
+}
+
+func (a Alert) amount(cents int64) string {
+	if a.Currency == "" {
+		return money.Format(cents)
+	}
+	return money.Format(cents) + " " + a.Currency
+}
@@ -0,0 +1,38 @@
+package goaltrack
+
+import "fmt"
+
+// Contribution types.
+const (
+	Deposit    = "deposit"
+	Withdrawal = "withdrawal"
+)
+
+// Contribution sources say what put an entry in a goal's ledger.
+const (
+	// SourceManual entries are deposits and withdrawals the user records.
+	SourceManual = "manual"
+	// SourceTransaction entries follow a transaction tagged with the goal.
+	SourceTransaction = "transaction"
+	// SourceOpening entries hold the amount a goal started with.
+	SourceOpening = "opening"
+	// SourceAdjustment entries record the user setting the current amount
+	// directly.
+	SourceAdjustment = "adjustment"
+)
+
+// Signed checks a contribution and returns its amount as it moves the goal:
+// positive for a deposit, negative for a withdrawal. An empty type is a
+// deposit.
+func Signed(kind string, amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, fmt.Errorf("contribution amount must be positive")
+	}
+	switch kind {
+	case "", Deposit:
+		return amount, nil
+	case Withdrawal:
+		return -amount, nil
+	}
+	return 0, fmt.Errorf("unknown contribution type %q", kind)
+}
@@ -0,0 +1,64 @@
+package goaltrack
+
+import (
+	"fmt"
+	"time"
+)
+
+// Tracking modes say where a goal's progress comes from.
+const (
+	// Manual goals keep whatever current amount the user sets.
+	Manual = "manual"
+	// Accounts goals are as far along as the balances of their linked
+	// accounts.
+	Accounts = "accounts"
+	// Contributions goals add up the transactions tagged with the goal.
+	Contributions = "contributions"
+)
+
+const (
+	InProgress = "in_progress"
+	Achieved   = "achieved"
+	Missed     = "missed"
+)
+
+// Tracking checks a tracking mode. An empty mode is Manual, unless accounts
+// are given, which makes it Accounts.
+func Tracking(mode string, accountIDs []string) (string, error) {
+	if mode == "" {
+		if len(accountIDs) > 0 {
+			return Accounts, nil
+		}
+		return Manual, nil
+	}
+
+	switch mode {
+	case Manual, Contributions:
+	case Accounts:
+		if len(accountIDs) == 0 {
+			return "", fmt.Errorf("a goal tracking accounts needs at least one account")
+		}
+	default:
+		return "", fmt.Errorf("unknown goal tracking %q", mode)
+	}
+	return mode, nil
+}
+
+// Status returns the status a goal should have. Reaching the target achieves
+// it, and passing the deadline short of the target misses it. A status set by
+// hand that is not one of the automatic ones, such as "paused", is kept.
+func Status(current, target int64, deadline, now time.Time, status string) string {
+	switch status {
+	case "", InProgress, Achieved, Missed:
+	default:
+		return status
+	}
+
+	switch {
+	case target > 0 && current >= target:
+		return Achieved
+	case !deadline.IsZero() && !now.Before(deadline.AddDate(0, 0, 1)):
+		return Missed
+	}
+	return InProgress
+}
@@ -0,0 +1,63 @@
+package goaltrack
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Milestone is a checkpoint on the way to a goal, set either as a percentage
+// of the target or as an amount in minor units.
+type Milestone struct {
+	ID         string
+	Percent    int32
+	Amount     int64
+	TargetDate time.Time
+	// ReachedAt is zero until the milestone is reached.
+	ReachedAt time.Time
+}
+
+// Threshold is the progress at which the milestone is reached.
+func (m Milestone) Threshold(target int64) int64 {
+	if m.Percent > 0 {
+		return ceilDiv(target*int64(m.Percent), 100)
+	}
+	return m.Amount
+}
+
+// Milestones checks milestones against the goal's target and orders them by
+// threshold.
+func Milestones(milestones []Milestone, target int64) ([]Milestone, error) {
+	for _, m := range milestones {
+		switch {
+		case m.Percent != 0 && m.Amount != 0:
+			return nil, fmt.Errorf("a milestone takes either a percent or an amount, not both")
+		case m.Percent < 0 || m.Percent > 100:
+			return nil, fmt.Errorf("milestone percent %d is out of range", m.Percent)
+		case m.Amount < 0:
+			return nil, fmt.Errorf("milestone amount cannot be negative")
+		case m.Percent == 0 && m.Amount == 0:
+			return nil, fmt.Errorf("a milestone needs a percent or an amount")
+		case target > 0 && m.Amount > target:
+			return nil, fmt.Errorf("milestone amount is above the goal's target")
+		}
+	}
+
+	sorted := append([]Milestone(nil), milestones...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Threshold(target) < sorted[j].Threshold(target)
+	})
+	return sorted, nil
+}
+
+// Reached returns the indexes of the milestones not reached before that
+// current progress now reaches.
+func Reached(milestones []Milestone, target, current int64) []int {
+	var reached []int
+	for i, m := range milestones {
+		if m.ReachedAt.IsZero() && current >= m.Threshold(target) {
+			reached = append(reached, i)
+		}
+	}
+	return reached
+}
@@ -0,0 +1,80 @@
+package goaltrack
+
+import "time"
+
+// Paces say whether a goal will be reached by its deadline at the rate it has
+// been growing recently.
+const (
+	OnTrack = "on_track"
+	// AtRisk goals are growing at least half as fast as they need to.
+	AtRisk = "at_risk"
+	Behind = "behind"
+)
+
+// RecentWindow is how many days of history the contribution rate is taken
+// from.
+const RecentWindow = 90
+
+// Projection is where a goal is heading. Amounts are in minor units.
+type Projection struct {
+	Remaining       int64
+	MonthlyRequired int64
+	WeeklyRequired  int64
+	MonthlyRate     int64
+	// Completion is the day the target is reached at MonthlyRate, or zero if
+	// it never is.
+	Completion time.Time
+	Pace       string
+}
+
+// Project works out what a goal still needs from now until its deadline, and
+// where it is heading given that contributed was added over the last window
+// days. A month is an average calendar month of 365.25/12 days.
+func Project(target, current int64, deadline, now time.Time, contributed int64, window int) Projection {
+	today := now.UTC().Truncate(24 * time.Hour)
+	projection := Projection{Remaining: target - current, Pace: OnTrack}
+	if window > 0 {
+		projection.MonthlyRate = ceilDiv(contributed*1461, int64(window)*48)
+	}
+	if projection.Remaining <= 0 {
+		projection.Remaining = 0
+		projection.Completion = today
+		return projection
+	}
+
+	daysLeft := int64(deadline.Sub(today).Hours()/24) + 1
+	if daysLeft <= 0 {
+		projection.MonthlyRequired = projection.Remaining
+		projection.WeeklyRequired = projection.Remaining
+		projection.Pace = Behind
+	} else {
+		projection.MonthlyRequired = min(ceilDiv(projection.Remaining*1461, daysLeft*48), projection.Remaining)
+		projection.WeeklyRequired = min(ceilDiv(projection.Remaining*7, daysLeft), projection.Remaining)
+	}
+
+	if contributed > 0 && window > 0 {
+		days := ceilDiv(projection.Remaining*int64(window), contributed)
+		projection.Completion = today.AddDate(0, 0, int(days))
+	}
+
+	if projection.Pace == Behind || window == 0 {
+		return projection
+	}
+	switch {
+	case projection.MonthlyRate >= projection.MonthlyRequired:
+		projection.Pace = OnTrack
+	case projection.MonthlyRate*2 >= projection.MonthlyRequired:
+		projection.Pace = AtRisk
+	default:
+		projection.Pace = Behind
+	}
+	return projection
+}
+
+// ceilDiv divides a non-negative a by a positive b, rounding up.
+func ceilDiv(a, b int64) int64 {
+	if a <= 0 {
+		return 0
+	}
+	return (a + b - 1) / b
+}
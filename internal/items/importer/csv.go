@@ -0,0 +1,218 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// NegativeExpense treats negative amounts as money leaving the account,
+	// the usual convention for bank statements.
+	NegativeExpense = "negative_expense"
+	// PositiveExpense treats positive amounts as spending, the usual
+	// convention for credit card statements.
+	PositiveExpense = "positive_expense"
+)
+
+// CSVMapping says where each field lives in a CSV statement. Columns are
+// header names when HasHeader is set, or 1-based positions otherwise. Either
+// AmountColumn or both DebitColumn and CreditColumn must be set.
+type CSVMapping struct {
+	Delimiter         string
+	HasHeader         bool
+	DateColumn        string
+	DateFormat        string
+	AmountColumn      string
+	DebitColumn       string
+	CreditColumn      string
+	DescriptionColumn string
+	AmountSign        string
+	DecimalSeparator  string
+}
+
+type csvColumns struct {
+	date, amount, debit, credit, description int
+}
+
+func ParseCSV(data []byte, mapping CSVMapping) ([]Row, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if mapping.Delimiter != "" {
+		delimiter := []rune(mapping.Delimiter)
+		if len(delimiter) != 1 {
+			return nil, fmt.Errorf("delimiter must be a single character, got %q", mapping.Delimiter)
+		}
+		reader.Comma = delimiter[0]
+	}
+
+	switch mapping.AmountSign {
+	case "":
+		mapping.AmountSign = NegativeExpense
+	case NegativeExpense, PositiveExpense:
+	default:
+		return nil, fmt.Errorf("unknown amount sign convention %q", mapping.AmountSign)
+	}
+
+	var header []string
+	if mapping.HasHeader {
+		record, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("reading header: %w", err)
+		}
+		header = record
+	}
+
+	columns, err := resolveColumns(mapping, header)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := dateLayout(mapping.DateFormat)
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, Row{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		if isBlank(record) {
+			continue
+		}
+
+		rows = append(rows, parseCSVRecord(record, line, columns, layout, mapping))
+	}
+
+	return rows, nil
+}
+
+func parseCSVRecord(record []string, line int, columns csvColumns, layout string, mapping CSVMapping) Row {
+	row := Row{Line: line}
+
+	field := func(index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	date, err := time.Parse(layout, field(columns.date))
+	if err != nil {
+		row.Err = fmt.Errorf("invalid date %q", field(columns.date))
+		return row
+	}
+	row.Date = date
+	row.Description = field(columns.description)
+
+	var signed int64
+	if columns.amount >= 0 {
+		amount, err := parseAmount(field(columns.amount), mapping.DecimalSeparator)
+		if err != nil {
+			row.Err = err
+			return row
+		}
+		signed = amount
+		if mapping.AmountSign == PositiveExpense {
+			signed = -amount
+		}
+	} else {
+		debit, credit := field(columns.debit), field(columns.credit)
+		if debit != "" {
+			amount, err := parseAmount(debit, mapping.DecimalSeparator)
+			if err != nil {
+				row.Err = err
+				return row
+			}
+			signed -= abs(amount)
+		}
+		if credit != "" {
+			amount, err := parseAmount(credit, mapping.DecimalSeparator)
+			if err != nil {
+				row.Err = err
+				return row
+			}
+			signed += abs(amount)
+		}
+	}
+
+	if signed == 0 {
+		row.Err = errors.New("amount is zero or missing")
+		return row
+	}
+
+	return signedRow(row, signed)
+}
+
+func resolveColumns(mapping CSVMapping, header []string) (csvColumns, error) {
+	columns := csvColumns{date: -1, amount: -1, debit: -1, credit: -1, description: -1}
+
+	resolve := func(name, column string, required bool) (int, error) {
+		if column == "" {
+			if required {
+				return -1, fmt.Errorf("%s column is required", name)
+			}
+			return -1, nil
+		}
+		for i, title := range header {
+			if strings.EqualFold(strings.TrimSpace(title), column) {
+				return i, nil
+			}
+		}
+		position, err := strconv.Atoi(column)
+		if err != nil || position < 1 {
+			return -1, fmt.Errorf("%s column %q not found", name, column)
+		}
+		return position - 1, nil
+	}
+
+	var err error
+	if columns.date, err = resolve("date", mapping.DateColumn, true); err != nil {
+		return columns, err
+	}
+	if columns.description, err = resolve("description", mapping.DescriptionColumn, false); err != nil {
+		return columns, err
+	}
+	if mapping.AmountColumn != "" {
+		columns.amount, err = resolve("amount", mapping.AmountColumn, true)
+		return columns, err
+	}
+	if columns.debit, err = resolve("debit", mapping.DebitColumn, true); err != nil {
+		return columns, fmt.Errorf("either an amount column or debit and credit columns are required: %w", err)
+	}
+	if columns.credit, err = resolve("credit", mapping.CreditColumn, true); err != nil {
+		return columns, fmt.Errorf("either an amount column or debit and credit columns are required: %w", err)
+	}
+
+	return columns, nil
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,109 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"budgeting-service/internal/items/money"
+)
+
+const (
+	Income  = "income"
+	Expense = "expense"
+)
+
+// Row is one statement entry turned into a transaction. Line locates the entry
+// in the file: its line for CSV and QIF, its position for OFX. Amount is in
+// minor units and always positive; Type says which way the money moved.
+// ExternalID is the bank's own transaction ID when the format has one. Err is
+// set instead when the entry could not be parsed, so the caller can report it
+// and carry on.
+type Row struct {
+	Line        int
+	Date        time.Time
+	Amount      int64
+	Type        string
+	Description string
+	ExternalID  string
+	Err         error
+}
+
+// Statement is a parsed statement file. LedgerBalance is the closing balance
+// reported by the bank as of LedgerDate in minor units, or nil when the
+// format has none.
+type Statement struct {
+	Rows          []Row
+	Currency      string
+	LedgerBalance *int64
+	LedgerDate    time.Time
+}
+
+// ParseStatement parses an OFX, QFX or QIF file. dateFormat only applies to
+// QIF, whose dates carry no fixed order.
+func ParseStatement(format string, data []byte, dateFormat string) (*Statement, error) {
+	switch strings.ToLower(format) {
+	case "ofx", "qfx":
+		return ParseOFX(data)
+	case "qif":
+		return ParseQIF(data, dateFormat)
+	}
+	return nil, fmt.Errorf("unsupported statement format %q", format)
+}
+
+func signedRow(row Row, signed int64) Row {
+	row.Type, row.Amount = Income, signed
+	if signed < 0 {
+		row.Type, row.Amount = Expense, -signed
+	}
+	return row
+}
+
+// parseAmount reads a statement amount such as "1 234,50", "-12.00" or
+// "(12.00)". decimalSeparator is "." or ","; the other one, along with spaces,
+// is treated as a thousands separator. The result is in minor units.
+func parseAmount(value, decimalSeparator string) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	}
+
+	thousands := ","
+	if decimalSeparator == "," {
+		thousands = "."
+	}
+	value = strings.NewReplacer(thousands, "", " ", "", " ", "", "'", "").Replace(value)
+	if decimalSeparator == "," {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	amount, err := money.Parse(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+// dateLayout turns a human date format such as "DD.MM.YYYY" into a Go time
+// layout. Strings that are already Go layouts pass through unchanged.
+func dateLayout(format string) string {
+	if format == "" {
+		return "2006-01-02"
+	}
+
+	return strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MM", "01",
+		"DD", "02",
+		"M", "1",
+		"D", "2",
+	).Replace(format)
+}
@@ -0,0 +1,167 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+type ofxTag struct {
+	name    string
+	closing bool
+	text    string
+}
+
+// ParseOFX parses OFX and QFX statements in both the SGML (OFX 1.x) and XML
+// (OFX 2.x) variants. The two differ only in that SGML leaves leaf elements
+// unclosed, so the body is read as a flat run of tags, each followed by the
+// text up to the next tag.
+func ParseOFX(data []byte) (*Statement, error) {
+	body := string(data)
+	start := strings.Index(strings.ToUpper(body), "<OFX>")
+	if start < 0 {
+		return nil, errors.New("not an OFX file: missing <OFX> element")
+	}
+
+	statement := &Statement{}
+
+	var transaction map[string]string
+	inLedger := false
+	var ledger map[string]string
+
+	for _, tag := range ofxTags(body[start:]) {
+		switch {
+		case tag.name == "STMTTRN" && !tag.closing:
+			transaction = make(map[string]string)
+		case tag.name == "STMTTRN" && tag.closing:
+			if transaction != nil {
+				statement.Rows = append(statement.Rows, ofxRow(len(statement.Rows)+1, transaction))
+			}
+			transaction = nil
+		case tag.name == "LEDGERBAL" && !tag.closing:
+			inLedger = true
+			ledger = make(map[string]string)
+		case tag.name == "LEDGERBAL" && tag.closing:
+			inLedger = false
+		case tag.closing || tag.text == "":
+		case transaction != nil:
+			transaction[tag.name] = tag.text
+		case inLedger:
+			ledger[tag.name] = tag.text
+		case tag.name == "CURDEF":
+			statement.Currency = tag.text
+		}
+	}
+
+	if ledger != nil && ledger["BALAMT"] != "" {
+		balance, err := parseAmount(ledger["BALAMT"], ofxDecimalSeparator(ledger["BALAMT"]))
+		if err != nil {
+			return nil, fmt.Errorf("ledger balance: %w", err)
+		}
+		statement.LedgerBalance = &balance
+		if asOf, err := parseOFXDate(ledger["DTASOF"]); err == nil {
+			statement.LedgerDate = asOf
+		}
+	}
+
+	return statement, nil
+}
+
+func ofxRow(position int, fields map[string]string) Row {
+	row := Row{Line: position, ExternalID: fields["FITID"]}
+
+	date, err := parseOFXDate(fields["DTPOSTED"])
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	row.Date = date
+
+	row.Description = fields["NAME"]
+	if memo := fields["MEMO"]; memo != "" && memo != row.Description {
+		if row.Description == "" {
+			row.Description = memo
+		} else {
+			row.Description += " - " + memo
+		}
+	}
+
+	amount, err := parseAmount(fields["TRNAMT"], ofxDecimalSeparator(fields["TRNAMT"]))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	if amount == 0 {
+		row.Err = errors.New("amount is zero or missing")
+		return row
+	}
+
+	return signedRow(row, amount)
+}
+
+func ofxTags(body string) []ofxTag {
+	var tags []ofxTag
+	for {
+		open := strings.IndexByte(body, '<')
+		if open < 0 {
+			return tags
+		}
+		end := strings.IndexByte(body[open:], '>')
+		if end < 0 {
+			return tags
+		}
+		end += open
+
+		name := strings.TrimSpace(body[open+1 : end])
+		body = body[end+1:]
+
+		if strings.HasPrefix(name, "?") || strings.HasPrefix(name, "!") {
+			continue
+		}
+
+		tag := ofxTag{}
+		if strings.HasPrefix(name, "/") {
+			tag.closing = true
+			name = name[1:]
+		}
+		if fields := strings.Fields(name); len(fields) > 0 {
+			name = fields[0]
+		}
+		tag.name = strings.ToUpper(name)
+
+		if !tag.closing {
+			next := strings.IndexByte(body, '<')
+			if next < 0 {
+				next = len(body)
+			}
+			tag.text = html.UnescapeString(strings.TrimSpace(body[:next]))
+		}
+
+		tags = append(tags, tag)
+	}
+}
+
+// parseOFXDate reads the date part of an OFX datetime such as
+// "20240131120000.000[-5:EST]". Statements only carry dates, so the time and
+// zone are dropped.
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return date, nil
+}
+
+// ofxDecimalSeparator guesses the separator of an OFX amount. The spec asks
+// for a period, but some banks write "-12,50".
+func ofxDecimalSeparator(value string) string {
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		return ","
+	}
+	return "."
+}
@@ -0,0 +1,168 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// qifTransactionTypes are the QIF sections that hold plain transactions.
+// Investment, category and memorized sections are skipped.
+var qifTransactionTypes = map[string]bool{
+	"bank":  true,
+	"cash":  true,
+	"ccard": true,
+	"oth a": true,
+	"oth l": true,
+}
+
+// ParseQIF parses a QIF file. QIF dates have no fixed order, so dateFormat
+// says whether the day or the month comes first: "DD/MM/YYYY" for day first,
+// anything else (the default) for the US order.
+func ParseQIF(data []byte, dateFormat string) (*Statement, error) {
+	dayFirst := strings.HasPrefix(strings.ToUpper(strings.TrimSpace(dateFormat)), "D")
+
+	statement := &Statement{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	inTransactions := false
+	sawHeader := false
+	fields := make(map[byte]string)
+	start, line := 0, 0
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if line == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "!") {
+			header := strings.ToLower(strings.TrimSpace(text[1:]))
+			if strings.HasPrefix(header, "type:") {
+				sawHeader = true
+				inTransactions = qifTransactionTypes[strings.TrimSpace(strings.TrimPrefix(header, "type:"))]
+			} else if header == "account" {
+				inTransactions = false
+			}
+			continue
+		}
+
+		if text[0] == '^' {
+			if inTransactions && len(fields) > 0 {
+				statement.Rows = append(statement.Rows, qifRow(start, fields, dayFirst))
+			}
+			fields = make(map[byte]string)
+			continue
+		}
+
+		if len(fields) == 0 {
+			start = line
+		}
+		// Split lines (S, E, $) repeat within one record; only the first
+		// occurrence of each field is kept.
+		if _, ok := fields[text[0]]; !ok {
+			fields[text[0]] = strings.TrimSpace(text[1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !sawHeader {
+		return nil, errors.New("not a QIF file: missing !Type header")
+	}
+
+	if inTransactions && len(fields) > 0 {
+		statement.Rows = append(statement.Rows, qifRow(start, fields, dayFirst))
+	}
+
+	return statement, nil
+}
+
+func qifRow(line int, fields map[byte]string, dayFirst bool) Row {
+	row := Row{Line: line}
+
+	date, err := parseQIFDate(fields['D'], dayFirst)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	row.Date = date
+
+	row.Description = fields['P']
+	if memo := fields['M']; memo != "" && memo != row.Description {
+		if row.Description == "" {
+			row.Description = memo
+		} else {
+			row.Description += " - " + memo
+		}
+	}
+
+	value := fields['T']
+	if value == "" {
+		value = fields['U']
+	}
+	amount, err := parseAmount(value, ".")
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	if amount == 0 {
+		row.Err = errors.New("amount is zero or missing")
+		return row
+	}
+
+	return signedRow(row, amount)
+}
+
+// parseQIFDate reads dates such as "1/31/2024", "1/31'24", " 1/ 5/24" or
+// "2024-01-31". Two-digit years fall in 1970-2069.
+func parseQIFDate(value string, dayFirst bool) (time.Time, error) {
+	normalized := strings.ReplaceAll(strings.ReplaceAll(value, " ", ""), "'", "/")
+	parts := strings.FieldsFunc(normalized, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.'
+	})
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", value)
+		}
+		numbers[i] = n
+	}
+
+	var year, month, day int
+	switch {
+	case len(parts[0]) == 4:
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	case dayFirst:
+		day, month, year = numbers[0], numbers[1], numbers[2]
+	default:
+		month, day, year = numbers[0], numbers[1], numbers[2]
+	}
+
+	if len(parts[2]) <= 2 && len(parts[0]) != 4 {
+		year += 1900
+		if year < 1970 {
+			year += 100
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,27 @@
+package jwttokens
+
+import "context"
+
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+	// RoleService marks calls made by this service itself, such as the Kafka
+	// consumer, rather than by an authenticated end user.
+	RoleService = "service"
+)
+
+type claimsKey struct{}
+
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// ServiceClaims returns the claims of an internal caller identified by name.
+func ServiceClaims(name string) *Claims {
+	return &Claims{Username: name, Role: RoleService}
+}
@@ -0,0 +1,32 @@
+package jwttokens
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func ParseToken(tokenString, jwtKey string) (*Claims, error) {
+	claims := &Claims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		// Only accept the method the tokens are minted with, so a token signed
+		// with "none" or an asymmetric algorithm is never taken as valid.
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return []byte(jwtKey), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if claims.UserID == "" {
+		return nil, errors.New("token has no user id")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	common_pb "budgeting-service/genproto/common"
+)
+
+// Amounts are stored and summed as int64 minor units: hundredths of the
+// currency unit, so 12.34 is 1234. Currencies whose minor unit is a
+// thousandth are rounded to hundredths.
+const (
+	centsPerUnit = 100
+	nanosPerCent = 10_000_000
+	maxUnits     = math.MaxInt64 / centsPerUnit
+)
+
+var (
+	ErrInvalid          = errors.New("invalid money amount")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// FromProto converts a Money message to minor units. A nil message is zero.
+// An amount with a fraction of a cent cannot be stored exactly and is
+// rejected rather than rounded.
+func FromProto(m *common_pb.Money) (int64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if m.Nanos <= -1_000_000_000 || m.Nanos >= 1_000_000_000 {
+		return 0, fmt.Errorf("%w: nanos %d out of range", ErrInvalid, m.Nanos)
+	}
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return 0, fmt.Errorf("%w: units and nanos have different signs", ErrInvalid)
+	}
+	if m.Units > maxUnits || m.Units < -maxUnits {
+		return 0, fmt.Errorf("%w: %d units is too large", ErrInvalid, m.Units)
+	}
+
+	if m.Nanos%nanosPerCent != 0 {
+		return 0, fmt.Errorf("%w: nanos %d is a fraction of a cent", ErrInvalid, m.Nanos)
+	}
+
+	return m.Units*centsPerUnit + int64(m.Nanos/nanosPerCent), nil
+}
+
+// Amount converts m to minor units and checks that it is in currency. A
+// message without a currency code is taken to be in currency.
+func Amount(m *common_pb.Money, currency string) (int64, error) {
+	if code := m.GetCurrencyCode(); code != "" && currency != "" && !strings.EqualFold(code, currency) {
+		return 0, fmt.Errorf("%w: amount is in %s, expected %s", ErrCurrencyMismatch, code, currency)
+	}
+	return FromProto(m)
+}
+
+func ToProto(cents int64, currency string) *common_pb.Money {
+	return &common_pb.Money{
+		Units:        cents / centsPerUnit,
+		Nanos:        int32(cents%centsPerUnit) * nanosPerCent,
+		CurrencyCode: currency,
+	}
+}
+
+// FromFloat converts a floating point amount, such as one left over from
+// before amounts were exact, to the nearest minor unit.
+func FromFloat(amount float64) int64 {
+	return int64(math.Round(amount * centsPerUnit))
+}
+
+// Float returns cents as a floating point amount, for ratios and display
+// only; never sum the result.
+func Float(cents int64) float64 {
+	return float64(cents) / centsPerUnit
+}
+
+// Convert applies an exchange rate, rounding to the nearest minor unit.
+func Convert(cents int64, rate float64) int64 {
+	return int64(math.Round(float64(cents) * rate))
+}
+
+// Parse reads a plain decimal such as "-1234.5" exactly, rounding half away
+// from zero past the second decimal.
+func Parse(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	negative := false
+	switch {
+	case strings.HasPrefix(value, "-"):
+		negative = true
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" && fraction == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, value)
+	}
+	for _, part := range []string{whole, fraction} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return 0, fmt.Errorf("%w: %q", ErrInvalid, value)
+			}
+		}
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil || units > maxUnits {
+			return 0, fmt.Errorf("%w: %q", ErrInvalid, value)
+		}
+	}
+
+	fraction += "000"
+	cents, _ := strconv.ParseInt(fraction[:2], 10, 64)
+	if fraction[2] >= '5' {
+		cents++
+	}
+
+	total := units*centsPerUnit + cents
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// Format writes cents as a plain decimal with two places, e.g. "-0.05".
+func Format(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/centsPerUnit, cents%centsPerUnit)
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"sync"
 
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
 	"budgeting-service/internal/items/service"
 
 	"github.com/segmentio/kafka-go"
@@ -33,9 +35,14 @@ func New(service *service.Service, logger *slog.Logger, readers *MsgBrokers, wg
 	}
 }
 
+// StartToConsume runs the consumers with service claims instead of a user's.
+// Messages come from other services over the internal broker, which is trusted
+// to carry the user id of the request it relays; no end user token is involved,
+// and the access policy, which guards the gRPC server, does not apply.
 func (m *MsgBroker) StartToConsume(ctx context.Context) {
 	m.wg.Add(4)
 
+	ctx = rbac.WithAnyUser(jwttokens.WithClaims(ctx, jwttokens.ServiceClaims("kafka-consumer")))
 	consumerCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -0,0 +1,30 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// Policies grant a role a gRPC method in one of two scopes: ScopeOwn lets the
+// role call the method on its own documents, ScopeAny on every user's.
+const (
+	ScopeOwn = "own"
+	ScopeAny = "any"
+)
+
+func NewEnforcer(modelPath, policyPath string) (*casbin.Enforcer, error) {
+	return casbin.NewEnforcer(modelPath, policyPath)
+}
+
+type anyUserKey struct{}
+
+// WithAnyUser marks a call as allowed to work on any user's documents.
+func WithAnyUser(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anyUserKey{}, true)
+}
+
+func AnyUser(ctx context.Context) bool {
+	anyUser, _ := ctx.Value(anyUserKey{}).(bool)
+	return anyUser
+}
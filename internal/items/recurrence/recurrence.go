@@ -0,0 +1,130 @@
+package recurrence
+
+import (
+	"time"
+
+	"budgeting-service/internal/items/validation"
+)
+
+const (
+	Daily    = "daily"
+	Weekly   = "weekly"
+	Biweekly = "biweekly"
+	Monthly  = "monthly"
+	Yearly   = "yearly"
+)
+
+// Rule is a small subset of an RRULE: a frequency, an interval between
+// occurrences and, for monthly rules, the day of the month. Occurrences are
+// whole days counted from Start.
+type Rule struct {
+	Frequency  string
+	Interval   int
+	DayOfMonth int
+	Start      time.Time
+}
+
+// Frequencies are the frequencies a Rule can have.
+var Frequencies = []string{Daily, Weekly, Biweekly, Monthly, Yearly}
+
+// Validate reports what is wrong with the rule against the request fields it
+// comes from.
+func (r Rule) Validate() error {
+	var v validation.Violations
+	v.OneOf("frequency", r.Frequency, Frequencies...)
+	if r.Interval < 0 {
+		v.Add("interval", "must not be negative")
+	}
+	if r.DayOfMonth < 0 || r.DayOfMonth > 31 {
+		v.Add("day_of_month", "must be between 1 and 31")
+	}
+	if r.Start.IsZero() {
+		v.Add("start_date", "is required")
+	}
+	return v.Err()
+}
+
+// First returns the first occurrence on or after Start.
+func (r Rule) First() time.Time {
+	return r.Next(r.Start.AddDate(0, 0, -1))
+}
+
+// Next returns the first occurrence strictly after t.
+func (r Rule) Next(t time.Time) time.Time {
+	start := truncate(r.Start)
+	t = truncate(t)
+
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	switch r.Frequency {
+	case Daily, Weekly, Biweekly:
+		step := interval
+		if r.Frequency == Weekly {
+			step *= 7
+		} else if r.Frequency == Biweekly {
+			step *= 14
+		}
+		if t.Before(start) {
+			return start
+		}
+		days := int(t.Sub(start).Hours() / 24)
+		return start.AddDate(0, 0, (days/step+1)*step)
+	case Monthly:
+		day := r.DayOfMonth
+		if day == 0 {
+			day = start.Day()
+		}
+		n := 0
+		if !t.Before(start) {
+			n = monthsBetween(start, t)/interval - 1
+			if n < 0 {
+				n = 0
+			}
+		}
+		for {
+			occurrence := dayInMonth(start.Year(), start.Month()+time.Month(n*interval), day)
+			if occurrence.After(t) && !occurrence.Before(start) {
+				return occurrence
+			}
+			n++
+		}
+	case Yearly:
+		n := 0
+		if !t.Before(start) {
+			n = (t.Year()-start.Year())/interval - 1
+			if n < 0 {
+				n = 0
+			}
+		}
+		for {
+			occurrence := dayInMonth(start.Year()+n*interval, start.Month(), start.Day())
+			if occurrence.After(t) && !occurrence.Before(start) {
+				return occurrence
+			}
+			n++
+		}
+	}
+
+	return time.Time{}
+}
+
+func truncate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+// dayInMonth returns the given day of the month, clamped to the last day of
+// months that are too short, so "monthly on the 31st" lands on Feb 28/29.
+func dayInMonth(year int, month time.Month, day int) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
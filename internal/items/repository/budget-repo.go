@@ -2,7 +2,9 @@ package repository
 
 import (
 	pb "budgeting-service/genproto/budget"
+	"budgeting-service/internal/items/budgetalert"
 	"context"
+	"time"
 )
 
 type BudgetI interface {
@@ -11,4 +13,11 @@ type BudgetI interface {
 	GetBudgetById(ctx context.Context, req *pb.GetBudgetByIdRequest) (*pb.BudgetResponse, error)
 	UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.BudgetResponse, error)
 	DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.Empty, error)
+	GetBudgetPeriods(ctx context.Context, req *pb.GetBudgetPeriodsRequest) (*pb.BudgetPeriodsResponse, error)
+	AssignToEnvelope(ctx context.Context, req *pb.AssignToEnvelopeRequest) (*pb.EnvelopeSummaryResponse, error)
+	MoveBetweenEnvelopes(ctx context.Context, req *pb.MoveBetweenEnvelopesRequest) (*pb.EnvelopeSummaryResponse, error)
+	GetEnvelopeSummary(ctx context.Context, req *pb.GetEnvelopeSummaryRequest) (*pb.EnvelopeSummaryResponse, error)
+	CheckBudgetAlerts(ctx context.Context, transactionID string) ([]*budgetalert.Alert, error)
+	RollOverBudgets(ctx context.Context, now time.Time) (int, error)
+	EnsureIndexes(ctx context.Context) error
 }
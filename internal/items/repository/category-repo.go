@@ -11,4 +11,12 @@ type CategoryI interface {
 	GetCategoryById(ctx context.Context, req *pb.GetCategoryByIdRequest) (*pb.CategoryResponse, error)
 	UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error)
 	DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.Empty, error)
+	MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.CategoryResponse, error)
+	GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.CategoryTreeResponse, error)
+	MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.CategoryResponse, error)
+	ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.CategoryResponse, error)
+	EnsureDefaultCategories(ctx context.Context, userID, locale string) error
+	SeedDefaultCategories(ctx context.Context, req *pb.SeedDefaultCategoriesRequest) (*pb.SeedDefaultCategoriesResponse, error)
+	PushDefaultCategories(ctx context.Context, req *pb.PushDefaultCategoriesRequest) (*pb.PushDefaultCategoriesResponse, error)
+	EnsureIndexes(ctx context.Context) error
 }
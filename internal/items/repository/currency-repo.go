@@ -0,0 +1,16 @@
+package repository
+
+import (
+	pb "budgeting-service/genproto/currency"
+	"budgeting-service/internal/items/exchange"
+	"context"
+)
+
+type CurrencyI interface {
+	GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.UserSettings, error)
+	UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UserSettings, error)
+	UpsertExchangeRates(ctx context.Context, req *pb.UpsertExchangeRatesRequest) (*pb.UpsertExchangeRatesResponse, error)
+	GetExchangeRate(ctx context.Context, req *pb.GetExchangeRateRequest) (*pb.ExchangeRate, error)
+	SaveExchangeRates(ctx context.Context, rates []exchange.Rate, source string) (int, error)
+	EnsureIndexes(ctx context.Context) error
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	pb "budgeting-service/genproto/goal"
+	"budgeting-service/internal/items/goaltrack"
 	"context"
+	"time"
 )
 
 type GoalI interface {
@@ -11,4 +13,11 @@ type GoalI interface {
 	GetGoalById(ctx context.Context, req *pb.GetGoalByIdRequest) (*pb.GoalResponse, error)
 	UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.GoalResponse, error)
 	DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*pb.Empty, error)
+	RefreshGoalProgress(ctx context.Context, userID string) ([]*goaltrack.Alert, error)
+	MarkMissedGoals(ctx context.Context, now time.Time) (int, error)
+	ProjectGoal(ctx context.Context, req *pb.ProjectGoalRequest) (*pb.GoalProjectionResponse, error)
+	CheckGoalPace(ctx context.Context, userID string, now time.Time) ([]*goaltrack.Alert, error)
+	AddGoalContribution(ctx context.Context, req *pb.AddGoalContributionRequest) (*pb.GoalContribution, error)
+	GetGoalContributions(ctx context.Context, req *pb.GetGoalContributionsRequest) (*pb.GoalContributionsResponse, error)
+	EnsureIndexes(ctx context.Context) error
 }
@@ -8,5 +8,6 @@ import (
 type NotificationI interface {
 	CreateNotification(ctx context.Context, req *pb.CreateNotificationRequest) (*pb.NotificationResponse, error)
 	GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.NotificationsResponse, error)
+	GetNotificationById(ctx context.Context, id string) (*pb.NotificationResponse, error)
 	MarkNotificationAsRead(ctx context.Context, req *pb.MarkNotificationAsReadRequest) (*pb.Empty, error)
 }
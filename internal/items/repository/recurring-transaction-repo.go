@@ -0,0 +1,18 @@
+package repository
+
+import (
+	pb "budgeting-service/genproto/transaction"
+	"context"
+	"time"
+)
+
+type RecurringTransactionI interface {
+	CreateRecurringTransaction(ctx context.Context, req *pb.CreateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error)
+	GetRecurringTransactions(ctx context.Context, req *pb.GetRecurringTransactionsRequest) (*pb.RecurringTransactionsResponse, error)
+	GetRecurringTransactionById(ctx context.Context, req *pb.GetRecurringTransactionByIdRequest) (*pb.RecurringTransactionResponse, error)
+	UpdateRecurringTransaction(ctx context.Context, req *pb.UpdateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error)
+	DeleteRecurringTransaction(ctx context.Context, req *pb.DeleteRecurringTransactionRequest) (*pb.Empty, error)
+	GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]*pb.RecurringTransactionResponse, error)
+	AdvanceRecurringTransaction(ctx context.Context, id string, occurrence, next time.Time) (bool, error)
+	EnsureIndexes(ctx context.Context) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	pb "budgeting-service/genproto/transaction"
+	"context"
+)
+
+type RuleI interface {
+	CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.RuleResponse, error)
+	GetRules(ctx context.Context, req *pb.GetRulesRequest) (*pb.RulesResponse, error)
+	GetRuleById(ctx context.Context, req *pb.GetRuleByIdRequest) (*pb.RuleResponse, error)
+	UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.RuleResponse, error)
+	DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.Empty, error)
+	PreviewRules(ctx context.Context, req *pb.PreviewRulesRequest) (*pb.PreviewRulesResponse, error)
+	ApplyRules(ctx context.Context, req *pb.ApplyRulesRequest) (*pb.ApplyRulesResponse, error)
+	EnsureIndexes(ctx context.Context) error
+}
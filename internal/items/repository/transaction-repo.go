@@ -2,6 +2,7 @@ package repository
 
 import (
 	pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/exporter"
 	"context"
 )
 
@@ -11,4 +12,9 @@ type TransactionI interface {
 	GetTransactionById(ctx context.Context, req *pb.GetTransactionByIdRequest) (*pb.TransactionResponse, error)
 	UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.TransactionResponse, error)
 	DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.Empty, error)
+	CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.TransferResponse, error)
+	ImportTransactions(ctx context.Context, req *pb.ImportTransactionsRequest) (*pb.ImportTransactionsResponse, error)
+	ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportTransactionsResponse, error)
+	ExportTransactions(ctx context.Context, req *pb.ExportTransactionsRequest, byAccount bool, fn func(*exporter.Transaction) error) error
+	EnsureIndexes(ctx context.Context) error
 }
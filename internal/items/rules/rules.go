@@ -0,0 +1,196 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"budgeting-service/internal/items/validation"
+)
+
+// Conditions pick the transactions a rule applies to. Every condition that is
+// set has to hold. Amounts are in cents and compared with the transaction's
+// amount when it is in Currency, or in any currency if Currency is empty; a
+// zero bound is no bound.
+type Conditions struct {
+	DescriptionContains string
+	DescriptionRegex    string
+	MinAmount           int64
+	MaxAmount           int64
+	Currency            string
+	AccountID           string
+	Type                string
+}
+
+// Actions are what a rule does to a transaction it matches.
+type Actions struct {
+	CategoryID     string
+	AddTags        []string
+	SetDescription string
+}
+
+// Rule is a user's rule, ready to be matched against transactions.
+type Rule struct {
+	ID         string
+	Conditions Conditions
+	Actions    Actions
+
+	regex *regexp.Regexp
+}
+
+// New checks a rule and prepares it for matching. Problems are reported
+// against the request fields they come from.
+func New(id string, conditions Conditions, actions Actions) (Rule, error) {
+	var v validation.Violations
+
+	if conditions == (Conditions{Currency: conditions.Currency}) {
+		v.Add("conditions", "at least one condition is required")
+	}
+	var regex *regexp.Regexp
+	if conditions.DescriptionRegex != "" {
+		var err error
+		if regex, err = regexp.Compile(conditions.DescriptionRegex); err != nil {
+			v.Add("conditions.description_regex", "%v", err)
+		}
+	}
+	if conditions.MinAmount < 0 {
+		v.Add("conditions.min_amount", "must not be negative")
+	}
+	if conditions.MaxAmount < 0 {
+		v.Add("conditions.max_amount", "must not be negative")
+	}
+	if conditions.MaxAmount > 0 && conditions.MaxAmount < conditions.MinAmount {
+		v.Add("conditions.max_amount", "must not be below min_amount")
+	}
+	v.OptionalOneOf("conditions.type", conditions.Type, validation.Income, validation.Expense)
+
+	actions.AddTags = Tags(actions.AddTags)
+	actions.SetDescription = strings.TrimSpace(actions.SetDescription)
+	if actions.CategoryID == "" && len(actions.AddTags) == 0 && actions.SetDescription == "" {
+		v.Add("actions", "at least one action is required")
+	}
+
+	if err := v.Err(); err != nil {
+		return Rule{}, err
+	}
+	return Rule{ID: id, Conditions: conditions, Actions: actions, regex: regex}, nil
+}
+
+// Transaction is what rules see of a transaction. Split transactions keep
+// their categories on the splits, so rules do not categorize them.
+type Transaction struct {
+	AccountID   string
+	Type        string
+	Description string
+	Amount      int64
+	Currency    string
+	CategoryID  string
+	Tags        []string
+	Split       bool
+}
+
+// Matches reports whether every condition of the rule holds for tx.
+func (r Rule) Matches(tx Transaction) bool {
+	c := r.Conditions
+	switch {
+	case c.AccountID != "" && c.AccountID != tx.AccountID:
+		return false
+	case c.Type != "" && c.Type != tx.Type:
+		return false
+	case c.DescriptionContains != "" && !strings.Contains(strings.ToLower(tx.Description), strings.ToLower(c.DescriptionContains)):
+		return false
+	case r.regex != nil && !r.regex.MatchString(tx.Description):
+		return false
+	}
+
+	if c.MinAmount > 0 || c.MaxAmount > 0 {
+		if c.Currency != "" && !strings.EqualFold(c.Currency, tx.Currency) {
+			return false
+		}
+		if c.MinAmount > 0 && tx.Amount < c.MinAmount {
+			return false
+		}
+		if c.MaxAmount > 0 && tx.Amount > c.MaxAmount {
+			return false
+		}
+	}
+	return true
+}
+
+// Result is what a set of rules does to one transaction. Empty fields leave
+// the transaction as it is.
+type Result struct {
+	RuleIDs     []string
+	CategoryID  string
+	AddTags     []string
+	Description string
+}
+
+// Changed reports whether the rules change anything.
+func (r Result) Changed() bool {
+	return r.CategoryID != "" || len(r.AddTags) > 0 || r.Description != ""
+}
+
+// Apply runs rules, in the order given, against a transaction as it arrived,
+// so a description one rule writes does not change what later rules match.
+// The first matching rule to set a category or a description has its way,
+// while tags are added from every matching rule. A category is only set on a
+// transaction that has none, and only if it is one of categories, which maps
+// the ids of the user's usable categories to their types, and takes the
+// transaction's type.
+func Apply(rules []Rule, tx Transaction, categories map[string]string) Result {
+	var result Result
+
+	has := make(map[string]bool, len(tx.Tags))
+	for _, tag := range tx.Tags {
+		has[tag] = true
+	}
+
+	for _, rule := range rules {
+		if !rule.Matches(tx) {
+			continue
+		}
+		result.RuleIDs = append(result.RuleIDs, rule.ID)
+
+		a := rule.Actions
+		if a.CategoryID != "" && result.CategoryID == "" && tx.CategoryID == "" && !tx.Split && categories[a.CategoryID] == tx.Type {
+			result.CategoryID = a.CategoryID
+		}
+		for _, tag := range a.AddTags {
+			if !has[tag] {
+				has[tag] = true
+				result.AddTags = append(result.AddTags, tag)
+			}
+		}
+		if a.SetDescription != "" && result.Description == "" && a.SetDescription != tx.Description {
+			result.Description = a.SetDescription
+		}
+	}
+
+	return result
+}
+
+// TagUpdate works out the tags an update leaves a transaction with. Given
+// tags replace the current ones, and so does an empty list when replace is
+// set, which clears them; ok is false when the tags are left as they are.
+func TagUpdate(tags []string, replace bool) (updated []string, ok bool) {
+	if len(tags) == 0 && !replace {
+		return nil, false
+	}
+	return Tags(tags), true
+}
+
+// Tags tidies tags for storage: trimmed, lower-cased, without blanks or
+// repeats, in the order given.
+func Tags(tags []string) []string {
+	tidy := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tidy = append(tidy, tag)
+	}
+	return tidy
+}
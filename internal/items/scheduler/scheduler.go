@@ -0,0 +1,228 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	notification_pb "budgeting-service/genproto/notification"
+	pb "budgeting-service/genproto/transaction"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+	"budgeting-service/internal/items/recurrence"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/service"
+	"budgeting-service/internal/items/storage"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Scheduler struct {
+	recurringstorage    repository.RecurringTransactionI
+	transactionservice  *service.TransactionService
+	budgetstorage       repository.BudgetI
+	goalstorage         repository.GoalI
+	notificationstorage repository.NotificationI
+	logger              *slog.Logger
+	interval            time.Duration
+}
+
+func New(storage storage.StrorageI, service *service.Service, logger *slog.Logger, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		recurringstorage:    storage.RecurringTransaction(),
+		transactionservice:  service.TransactionService,
+		budgetstorage:       storage.Budget(),
+		goalstorage:         storage.Goal(),
+		notificationstorage: storage.Notification(),
+		logger:              logger,
+		interval:            interval,
+	}
+}
+
+// Start runs every due job once immediately, so occurrences missed while the
+// service was down are caught up, and then again on every tick until ctx is
+// cancelled. Like the Kafka consumer, it acts with service claims for whichever
+// user a job belongs to.
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx = rbac.WithAnyUser(jwttokens.WithClaims(ctx, jwttokens.ServiceClaims("scheduler")))
+
+	if err := s.recurringstorage.EnsureIndexes(ctx); err != nil {
+		s.logger.Error("Scheduler could not ensure indexes", slog.Any("error", err))
+	}
+	if err := s.budgetstorage.EnsureIndexes(ctx); err != nil {
+		s.logger.Error("Scheduler could not ensure indexes", slog.Any("error", err))
+	}
+	if err := s.goalstorage.EnsureIndexes(ctx); err != nil {
+		s.logger.Error("Scheduler could not ensure indexes", slog.Any("error", err))
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runRecurringTransactions(ctx, time.Now().UTC())
+		s.runBudgetRollovers(ctx, time.Now().UTC())
+		s.runGoalDeadlines(ctx, time.Now().UTC())
+		s.runGoalPace(ctx, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runRecurringTransactions(ctx context.Context, now time.Time) {
+	due, err := s.recurringstorage.GetDueRecurringTransactions(ctx, now)
+	if err != nil {
+		s.logger.Error("Error fetching due recurring transactions", slog.Any("error", err))
+		return
+	}
+
+	for _, recurring := range due {
+		if err := s.catchUp(ctx, recurring, now); err != nil {
+			s.logger.Error("Error running recurring transaction", slog.String("id", recurring.Id), slog.Any("error", err))
+		}
+	}
+}
+
+func (s *Scheduler) runBudgetRollovers(ctx context.Context, now time.Time) {
+	closed, err := s.budgetstorage.RollOverBudgets(ctx, now)
+	if err != nil {
+		s.logger.Error("Error rolling budgets over", slog.Any("error", err))
+		return
+	}
+	if closed > 0 {
+		s.logger.Info("Budget periods closed", slog.Int("count", closed))
+	}
+}
+
+func (s *Scheduler) runGoalDeadlines(ctx context.Context, now time.Time) {
+	missed, err := s.goalstorage.MarkMissedGoals(ctx, now)
+	if err != nil {
+		s.logger.Error("Error marking missed goals", slog.Any("error", err))
+		return
+	}
+	if missed > 0 {
+		s.logger.Info("Goals missed", slog.Int("count", missed))
+	}
+}
+
+// runGoalPace catches goals that fall at risk simply because time passes
+// without anything being added to them.
+func (s *Scheduler) runGoalPace(ctx context.Context, now time.Time) {
+	alerts, err := s.goalstorage.CheckGoalPace(ctx, "", now)
+	if err != nil {
+		s.logger.Error("Error checking goal pace", slog.Any("error", err))
+		return
+	}
+
+	for _, alert := range alerts {
+		_, err := s.notificationstorage.CreateNotification(ctx, &notification_pb.CreateNotificationRequest{
+			UserId:  alert.UserID,
+			Message: alert.Message(),
+		})
+		if err != nil {
+			s.logger.Error("Error creating goal notification", slog.String("goal_id", alert.GoalID), slog.Any("error", err))
+			continue
+		}
+		s.logger.Info("Goal at risk", slog.String("goal_id", alert.GoalID))
+	}
+}
+
+// catchUp creates every occurrence of a recurring transaction up to now. An
+// occurrence that already exists is skipped thanks to the unique index on
+// (recurring_id, date), and the schedule is advanced with a compare-and-set,
+// so no occurrence is ever created twice. Occurrences are created through the
+// transaction service, so they raise budget alerts and move goals the way any
+// other new transaction does. An occurrence the service rejects, say because
+// its category was archived, would be rejected on every retry, so it is
+// skipped and the user told instead.
+func (s *Scheduler) catchUp(ctx context.Context, recurring *pb.RecurringTransactionResponse, now time.Time) error {
+	startDate, err := time.Parse("2006-01-02", recurring.StartDate)
+	if err != nil {
+		return err
+	}
+	occurrence, err := time.Parse("2006-01-02", recurring.NextRunDate)
+	if err != nil {
+		return err
+	}
+
+	var endDate time.Time
+	if recurring.EndDate != "" {
+		if endDate, err = time.Parse("2006-01-02", recurring.EndDate); err != nil {
+			return err
+		}
+	}
+
+	rule := recurrence.Rule{
+		Frequency:  recurring.Frequency,
+		Interval:   int(recurring.Interval),
+		DayOfMonth: int(recurring.DayOfMonth),
+		Start:      startDate,
+	}
+
+	for !occurrence.After(now) && (endDate.IsZero() || !occurrence.After(endDate)) {
+		_, err := s.transactionservice.CreateTransaction(ctx, &pb.CreateTransactionRequest{
+			UserId:      recurring.UserId,
+			AccountId:   recurring.AccountId,
+			CategoryId:  recurring.CategoryId,
+			Amount:      recurring.Amount,
+			Type:        recurring.Type,
+			Description: recurring.Description,
+			Date:        occurrence.Format("2006-01-02"),
+			RecurringId: recurring.Id,
+		})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			if !rejected(err) {
+				return err
+			}
+			s.skipOccurrence(ctx, recurring, occurrence, err)
+		}
+
+		next := rule.Next(occurrence)
+		advanced, err := s.recurringstorage.AdvanceRecurringTransaction(ctx, recurring.Id, occurrence, next)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return nil
+		}
+
+		s.logger.Info("Recurring occurrence processed", slog.String("id", recurring.Id), slog.Time("date", occurrence))
+		occurrence = next
+	}
+
+	return nil
+}
+
+// rejected reports whether err is the transaction service refusing the
+// occurrence itself rather than failing to store it.
+func rejected(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
+		return true
+	}
+	return false
+}
+
+func (s *Scheduler) skipOccurrence(ctx context.Context, recurring *pb.RecurringTransactionResponse, occurrence time.Time, reason error) {
+	s.logger.Warn("Recurring occurrence skipped",
+		slog.String("id", recurring.Id),
+		slog.Time("date", occurrence),
+		slog.Any("error", reason),
+	)
+
+	_, err := s.notificationstorage.CreateNotification(ctx, &notification_pb.CreateNotificationRequest{
+		UserId:  recurring.UserId,
+		Message: fmt.Sprintf("Recurring transaction %q was not recorded for %s: %s", recurring.Description, occurrence.Format("2006-01-02"), status.Convert(reason).Message()),
+	})
+	if err != nil {
+		s.logger.Error("Error creating recurring notification", slog.String("id", recurring.Id), slog.Any("error", err))
+	}
+}
@@ -9,38 +9,103 @@ import (
 
 type AccountService struct {
 	pb.UnimplementedAccountServiceServer
-	accountstorage repository.AccountI
-	logger         *slog.Logger
+	accountstorage      repository.AccountI
+	goalstorage         repository.GoalI
+	notificationstorage repository.NotificationI
+	logger              *slog.Logger
 }
 
-func NewAccountService(accountstorage repository.AccountI, logger *slog.Logger) *AccountService {
+func NewAccountService(accountstorage repository.AccountI, goalstorage repository.GoalI, notificationstorage repository.NotificationI, logger *slog.Logger) *AccountService {
 	return &AccountService{
-		accountstorage: accountstorage,
-		logger:         logger,
+		accountstorage:      accountstorage,
+		goalstorage:         goalstorage,
+		notificationstorage: notificationstorage,
+		logger:              logger,
 	}
 }
 
 func (s *AccountService) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.AccountResponse, error) {
 	s.logger.Info("CreateAccount", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateAccount(req); err != nil {
+		return nil, err
+	}
+
 	return s.accountstorage.CreateAccount(ctx, req)
 }
 
 func (s *AccountService) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.AccountsResponse, error) {
 	s.logger.Info("GetAccounts", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.accountstorage.GetAccounts(ctx, req)
 }
 
 func (s *AccountService) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRequest) (*pb.AccountResponse, error) {
 	s.logger.Info("GetAccountById", "req", req)
-	return s.accountstorage.GetAccountById(ctx, req)
+	return s.ownedAccount(ctx, req.Id)
 }
 
 func (s *AccountService) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.AccountResponse, error) {
 	s.logger.Info("UpdateAccount", "req", req)
-	return s.accountstorage.UpdateAccount(ctx, req)
+
+	if err := validateUpdateAccount(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedAccount(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountstorage.UpdateAccount(ctx, req)
+	if err != nil || account == nil {
+		return account, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, account.UserId)
+
+	return account, nil
 }
 
 func (s *AccountService) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.Empty, error) {
 	s.logger.Info("DeleteAccount", "req", req)
-	return s.accountstorage.DeleteAccount(ctx, req)
+
+	account, err := s.ownedAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.accountstorage.DeleteAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, account.UserId)
+
+	return resp, nil
+}
+
+// ownedAccount loads a account and checks that the caller may touch it.
+func (s *AccountService) ownedAccount(ctx context.Context, id string) (*pb.AccountResponse, error) {
+	account, err := s.accountstorage.GetAccountById(ctx, &pb.GetAccountByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, notFound("account")
+	}
+	if err := authorizeOwner(ctx, account.UserId, "account"); err != nil {
+		return nil, err
+	}
+
+	return account, nil
 }
@@ -0,0 +1,60 @@
+package service
+
+import (
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// caller returns the claims the auth interceptor or the Kafka consumer put
+// into the context.
+func caller(ctx context.Context) (*jwttokens.Claims, error) {
+	claims, ok := jwttokens.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	return claims, nil
+}
+
+// requestUser settles which user a request acts for. Callers act for
+// themselves: an empty user id is filled in from the token and any other id is
+// refused, unless the access policy lets the caller reach any user's documents
+// for this method (see rbac.WithAnyUser).
+func requestUser(ctx context.Context, userID string) (string, error) {
+	claims, err := caller(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case userID == "" && claims.UserID != "":
+		return claims.UserID, nil
+	case userID == "":
+		return "", status.Error(codes.InvalidArgument, "user id is required")
+	case userID != claims.UserID && !rbac.AnyUser(ctx):
+		return "", status.Error(codes.PermissionDenied, "cannot act on behalf of another user")
+	}
+
+	return userID, nil
+}
+
+// authorizeOwner checks that the caller may touch a document owned by
+// ownerID. Another user's document is reported as missing rather than
+// forbidden, so ids cannot be probed for existence.
+func authorizeOwner(ctx context.Context, ownerID, kind string) error {
+	claims, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if ownerID != claims.UserID && !rbac.AnyUser(ctx) {
+		return notFound(kind)
+	}
+	return nil
+}
+
+func notFound(kind string) error {
+	return status.Errorf(codes.NotFound, "%s not found", kind)
+}
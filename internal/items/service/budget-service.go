@@ -22,21 +22,123 @@ func NewBudgetService(budgetstorage repository.BudgetI, logger *slog.Logger) *Bu
 
 func (s *BudgetService) CreateBudget(ctx context.Context, req *pb.CreateBudgetRequest) (*pb.BudgetResponse, error) {
 	s.logger.Info("CreateBudget", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateBudget(req); err != nil {
+		return nil, err
+	}
+
 	return s.budgetstorage.CreateBudget(ctx, req)
 }
 func (s *BudgetService) GetBudgets(ctx context.Context, req *pb.GetBudgetsRequest) (*pb.BudgetsResponse, error) {
 	s.logger.Info("GetBudgets", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.budgetstorage.GetBudgets(ctx, req)
 }
 func (s *BudgetService) GetBudgetById(ctx context.Context, req *pb.GetBudgetByIdRequest) (*pb.BudgetResponse, error) {
 	s.logger.Info("GetBudgetById", "req", req)
-	return s.budgetstorage.GetBudgetById(ctx, req)
+	return s.ownedBudget(ctx, req.Id)
 }
 func (s *BudgetService) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.BudgetResponse, error) {
 	s.logger.Info("UpdateBudget", "req", req)
+
+	if err := validateUpdateBudget(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedBudget(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	return s.budgetstorage.UpdateBudget(ctx, req)
 }
 func (s *BudgetService) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.Empty, error) {
 	s.logger.Info("DeleteBudget", "req", req)
+
+	if _, err := s.ownedBudget(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	return s.budgetstorage.DeleteBudget(ctx, req)
 }
+
+func (s *BudgetService) GetBudgetPeriods(ctx context.Context, req *pb.GetBudgetPeriodsRequest) (*pb.BudgetPeriodsResponse, error) {
+	s.logger.Info("GetBudgetPeriods", "req", req)
+
+	if _, err := s.ownedBudget(ctx, req.BudgetId); err != nil {
+		return nil, err
+	}
+
+	return s.budgetstorage.GetBudgetPeriods(ctx, req)
+}
+
+func (s *BudgetService) AssignToEnvelope(ctx context.Context, req *pb.AssignToEnvelopeRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("AssignToEnvelope", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateAssignToEnvelope(req); err != nil {
+		return nil, err
+	}
+
+	return s.budgetstorage.AssignToEnvelope(ctx, req)
+}
+
+func (s *BudgetService) MoveBetweenEnvelopes(ctx context.Context, req *pb.MoveBetweenEnvelopesRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("MoveBetweenEnvelopes", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateMoveBetweenEnvelopes(req); err != nil {
+		return nil, err
+	}
+
+	return s.budgetstorage.MoveBetweenEnvelopes(ctx, req)
+}
+
+func (s *BudgetService) GetEnvelopeSummary(ctx context.Context, req *pb.GetEnvelopeSummaryRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("GetEnvelopeSummary", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.budgetstorage.GetEnvelopeSummary(ctx, req)
+}
+
+// ownedBudget loads a budget and checks that the caller may touch it.
+func (s *BudgetService) ownedBudget(ctx context.Context, id string) (*pb.BudgetResponse, error) {
+	budget, err := s.budgetstorage.GetBudgetById(ctx, &pb.GetBudgetByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if budget == nil {
+		return nil, notFound("budget")
+	}
+	if err := authorizeOwner(ctx, budget.UserId, "budget"); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
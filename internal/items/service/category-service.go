@@ -22,25 +22,198 @@ func NewCategoryService(categorystorage repository.CategoryI, logger *slog.Logge
 
 func (s *CategoryService) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
 	s.logger.Info("CreateCategory", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateCategory(req); err != nil {
+		return nil, err
+	}
+
 	return s.categorystorage.CreateCategory(ctx, req)
 }
 
 func (s *CategoryService) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.CategoriesResponse, error) {
 	s.logger.Info("GetCategories", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := s.seedOwnCategories(ctx, userID, req.Locale); err != nil {
+		return nil, err
+	}
+
 	return s.categorystorage.GetCategories(ctx, req)
 }
 
 func (s *CategoryService) GetCategoryById(ctx context.Context, req *pb.GetCategoryByIdRequest) (*pb.CategoryResponse, error) {
 	s.logger.Info("GetCategoryById", "req", req)
-	return s.categorystorage.GetCategoryById(ctx, req)
+	return s.ownedCategory(ctx, req.Id)
 }
 
 func (s *CategoryService) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
 	s.logger.Info("UpdateCategory", "req", req)
+
+	if err := validateUpdateCategory(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedCategory(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	return s.categorystorage.UpdateCategory(ctx, req)
 }
 
 func (s *CategoryService) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.Empty, error) {
 	s.logger.Info("DeleteCategory", "req", req)
+
+	if err := validateID("id", req.Id, "reassign_to", req.ReassignTo); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedCategory(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	return s.categorystorage.DeleteCategory(ctx, req)
 }
+
+func (s *CategoryService) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("MoveCategory", "req", req)
+
+	if err := validateID("id", req.Id, "parent_id", req.ParentId); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedCategory(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	category, err := s.categorystorage.MoveCategory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, notFound("category")
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.CategoryTreeResponse, error) {
+	s.logger.Info("GetCategoryTree", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := s.seedOwnCategories(ctx, userID, req.Locale); err != nil {
+		return nil, err
+	}
+
+	return s.categorystorage.GetCategoryTree(ctx, req)
+}
+
+func (s *CategoryService) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("MergeCategories", "req", req)
+
+	if err := validateMergeCategories(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedCategory(ctx, req.SourceId); err != nil {
+		return nil, err
+	}
+	if _, err := s.ownedCategory(ctx, req.TargetId); err != nil {
+		return nil, err
+	}
+
+	category, err := s.categorystorage.MergeCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, notFound("category")
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("ArchiveCategory", "req", req)
+
+	if err := validateID("id", req.Id, "reassign_to", req.ReassignTo); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedCategory(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	category, err := s.categorystorage.ArchiveCategory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, notFound("category")
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) SeedDefaultCategories(ctx context.Context, req *pb.SeedDefaultCategoriesRequest) (*pb.SeedDefaultCategoriesResponse, error) {
+	s.logger.Info("SeedDefaultCategories", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.categorystorage.SeedDefaultCategories(ctx, req)
+}
+
+// PushDefaultCategories is limited to admins by the access policy.
+func (s *CategoryService) PushDefaultCategories(ctx context.Context, req *pb.PushDefaultCategoriesRequest) (*pb.PushDefaultCategoriesResponse, error) {
+	s.logger.Info("PushDefaultCategories")
+	return s.categorystorage.PushDefaultCategories(ctx, req)
+}
+
+// seedOwnCategories gives users their default categories, named in locale,
+// the first time they look at their own. Admins and internal callers reading
+// someone else's categories see them as they are.
+func (s *CategoryService) seedOwnCategories(ctx context.Context, userID, locale string) error {
+	claims, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.UserID != userID {
+		return nil
+	}
+	return s.categorystorage.EnsureDefaultCategories(ctx, userID, locale)
+}
+
+// ownedCategory loads a category and checks that the caller may touch it.
+func (s *CategoryService) ownedCategory(ctx context.Context, id string) (*pb.CategoryResponse, error) {
+	category, err := s.categorystorage.GetCategoryById(ctx, &pb.GetCategoryByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, notFound("category")
+	}
+	if err := authorizeOwner(ctx, category.UserId, "category"); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	pb "budgeting-service/genproto/currency"
+	"budgeting-service/internal/items/repository"
+	"context"
+	"log/slog"
+)
+
+type CurrencyService struct {
+	pb.UnimplementedCurrencyServiceServer
+	currencystorage repository.CurrencyI
+	logger          *slog.Logger
+}
+
+func NewCurrencyService(currencystorage repository.CurrencyI, logger *slog.Logger) *CurrencyService {
+	return &CurrencyService{
+		currencystorage: currencystorage,
+		logger:          logger,
+	}
+}
+
+func (s *CurrencyService) GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.UserSettings, error) {
+	s.logger.Info("GetUserSettings", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.currencystorage.GetUserSettings(ctx, req)
+}
+
+func (s *CurrencyService) UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UserSettings, error) {
+	s.logger.Info("UpdateUserSettings", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.currencystorage.UpdateUserSettings(ctx, req)
+}
+
+// UpsertExchangeRates is limited to admins by the access policy.
+func (s *CurrencyService) UpsertExchangeRates(ctx context.Context, req *pb.UpsertExchangeRatesRequest) (*pb.UpsertExchangeRatesResponse, error) {
+	s.logger.Info("UpsertExchangeRates", "rates", len(req.Rates))
+	return s.currencystorage.UpsertExchangeRates(ctx, req)
+}
+
+func (s *CurrencyService) GetExchangeRate(ctx context.Context, req *pb.GetExchangeRateRequest) (*pb.ExchangeRate, error) {
+	s.logger.Info("GetExchangeRate", "req", req)
+	return s.currencystorage.GetExchangeRate(ctx, req)
+}
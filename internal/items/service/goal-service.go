@@ -9,38 +9,150 @@ import (
 
 type GoalService struct {
 	pb.UnimplementedGoalServiceServer
-	goalstorage repository.GoalI
-	logger      *slog.Logger
+	goalstorage         repository.GoalI
+	notificationstorage repository.NotificationI
+	logger              *slog.Logger
 }
 
-func NewGoalService(goalstorage repository.GoalI, logger *slog.Logger) *GoalService {
+func NewGoalService(goalstorage repository.GoalI, notificationstorage repository.NotificationI, logger *slog.Logger) *GoalService {
 	return &GoalService{
-		goalstorage: goalstorage,
-		logger:      logger,
+		goalstorage:         goalstorage,
+		notificationstorage: notificationstorage,
+		logger:              logger,
 	}
 }
 
 func (s *GoalService) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest) (*pb.GoalResponse, error) {
 	s.logger.Info("CreateGoal", "req", req)
-	return s.goalstorage.CreateGoal(ctx, req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	goal, err := s.goalstorage.CreateGoal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.refreshed(ctx, goal)
 }
 
 func (s *GoalService) GetGoals(ctx context.Context, req *pb.GetGoalsRequest) (*pb.GoalsResponse, error) {
 	s.logger.Info("GetGoals", "req", req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.goalstorage.GetGoals(ctx, req)
 }
 
 func (s *GoalService) GetGoalById(ctx context.Context, req *pb.GetGoalByIdRequest) (*pb.GoalResponse, error) {
 	s.logger.Info("GetGoalById", "req", req)
-	return s.goalstorage.GetGoalById(ctx, req)
+	return s.ownedGoal(ctx, req.Id)
 }
 
 func (s *GoalService) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.GoalResponse, error) {
 	s.logger.Info("UpdateGoal", "req", req)
-	return s.goalstorage.UpdateGoal(ctx, req)
+
+	if _, err := s.ownedGoal(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	goal, err := s.goalstorage.UpdateGoal(ctx, req)
+	if err != nil || goal == nil {
+		return goal, err
+	}
+
+	return s.refreshed(ctx, goal)
 }
 
 func (s *GoalService) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*pb.Empty, error) {
 	s.logger.Info("DeleteGoal", "req", req)
+
+	if _, err := s.ownedGoal(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	return s.goalstorage.DeleteGoal(ctx, req)
 }
+
+func (s *GoalService) ProjectGoal(ctx context.Context, req *pb.ProjectGoalRequest) (*pb.GoalProjectionResponse, error) {
+	s.logger.Info("ProjectGoal", "req", req)
+
+	if _, err := s.ownedGoal(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	projection, err := s.goalstorage.ProjectGoal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if projection == nil {
+		return nil, notFound("goal")
+	}
+
+	return projection, nil
+}
+
+func (s *GoalService) AddGoalContribution(ctx context.Context, req *pb.AddGoalContributionRequest) (*pb.GoalContribution, error) {
+	s.logger.Info("AddGoalContribution", "req", req)
+
+	goal, err := s.ownedGoal(ctx, req.GoalId)
+	if err != nil {
+		return nil, err
+	}
+
+	contribution, err := s.goalstorage.AddGoalContribution(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if contribution == nil {
+		return nil, notFound("goal")
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, goal.UserId)
+
+	return contribution, nil
+}
+
+func (s *GoalService) GetGoalContributions(ctx context.Context, req *pb.GetGoalContributionsRequest) (*pb.GoalContributionsResponse, error) {
+	s.logger.Info("GetGoalContributions", "req", req)
+
+	if _, err := s.ownedGoal(ctx, req.GoalId); err != nil {
+		return nil, err
+	}
+
+	return s.goalstorage.GetGoalContributions(ctx, req)
+}
+
+// refreshed recomputes the user's goals after a change to one of them and
+// returns that goal as it stands afterwards.
+func (s *GoalService) refreshed(ctx context.Context, goal *pb.GoalResponse) (*pb.GoalResponse, error) {
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, goal.UserId)
+
+	current, err := s.goalstorage.GetGoalById(ctx, &pb.GetGoalByIdRequest{Id: goal.Id})
+	if err != nil || current == nil {
+		return goal, err
+	}
+	return current, nil
+}
+
+// ownedGoal loads a goal and checks that the caller may touch it.
+func (s *GoalService) ownedGoal(ctx context.Context, id string) (*pb.GoalResponse, error) {
+	goal, err := s.goalstorage.GetGoalById(ctx, &pb.GetGoalByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if goal == nil {
+		return nil, notFound("goal")
+	}
+	if err := authorizeOwner(ctx, goal.UserId, "goal"); err != nil {
+		return nil, err
+	}
+
+	return goal, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	notification_pb "budgeting-service/genproto/notification"
+	"budgeting-service/internal/items/goaltrack"
+	"budgeting-service/internal/items/repository"
+)
+
+// refreshGoals brings the user's goals up to date with their accounts and
+// contributions, and lets the user know about each goal just achieved, just
+// past a milestone or just fallen at risk. Failures are only logged, since the
+// change that triggered the refresh has already been made.
+func refreshGoals(ctx context.Context, goalstorage repository.GoalI, notificationstorage repository.NotificationI, logger *slog.Logger, userID string) {
+	alerts, err := goalstorage.RefreshGoalProgress(ctx, userID)
+	if err != nil {
+		logger.Error("Error refreshing goal progress", slog.String("user_id", userID), slog.Any("error", err))
+		return
+	}
+
+	atRisk, err := goalstorage.CheckGoalPace(ctx, userID, time.Now())
+	if err != nil {
+		logger.Error("Error checking goal pace", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	notifyGoals(ctx, notificationstorage, logger, append(alerts, atRisk...))
+}
+
+func notifyGoals(ctx context.Context, notificationstorage repository.NotificationI, logger *slog.Logger, alerts []*goaltrack.Alert) {
+	for _, alert := range alerts {
+		_, err := notificationstorage.CreateNotification(ctx, &notification_pb.CreateNotificationRequest{
+			UserId:  alert.UserID,
+			Message: alert.Message(),
+		})
+		if err != nil {
+			logger.Error("Error creating goal notification", slog.String("goal_id", alert.GoalID), slog.Any("error", err))
+			continue
+		}
+		logger.Info("Goal alert sent", slog.String("goal_id", alert.GoalID), slog.String("kind", alert.Kind))
+	}
+}
@@ -22,15 +22,41 @@ func NewNotificationService(notificationstorage repository.NotificationI, logger
 
 func (s *NotificationService) CreateNotification(ctx context.Context, req *pb.CreateNotificationRequest) (*pb.NotificationResponse, error) {
 	s.logger.Info("CreateNotification", slog.String("req", req.String()))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.notificationstorage.CreateNotification(ctx, req)
 }
 
 func (s *NotificationService) GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.NotificationsResponse, error) {
 	s.logger.Info("GetNotification", slog.String("id", req.UserId))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.notificationstorage.GetNotifications(ctx, req)
 }
 
 func (s *NotificationService) MarkNotificationAsRead(ctx context.Context, req *pb.MarkNotificationAsReadRequest) (*pb.Empty, error) {
 	s.logger.Info("MarkNotificationAsRead", slog.String("id", req.Id))
+
+	notification, err := s.notificationstorage.GetNotificationById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if notification == nil {
+		return nil, notFound("notification")
+	}
+	if err := authorizeOwner(ctx, notification.UserId, "notification"); err != nil {
+		return nil, err
+	}
+
 	return s.notificationstorage.MarkNotificationAsRead(ctx, req)
 }
@@ -22,20 +22,48 @@ func NewReportService(reportstorage repository.ReportI, logger *slog.Logger) *Re
 
 func (s *ReportService) GetSpendingReport(ctx context.Context, req *pb.GetSpendingReportRequest) (*pb.SpendingReportResponse, error) {
 	s.logger.Info("GetSpendingReport")
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.reportstorage.GetSpendingReport(ctx, req)
 }
 
 func (s *ReportService) GetIncomeReport(ctx context.Context, req *pb.GetIncomeReportRequest) (*pb.IncomeReportResponse, error) {
 	s.logger.Info("GetIncomeReport")
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.reportstorage.GetIncomeReport(ctx, req)
 }
 
 func (s *ReportService) GetBudgetPerformanceReport(ctx context.Context, req *pb.GetBudgetPerformanceReportRequest) (*pb.BudgetPerformanceReportResponse, error) {
 	s.logger.Info("GetBudgetPerformanceReport")
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.reportstorage.GetBudgetPerformanceReport(ctx, req)
 }
 
 func (s *ReportService) GetGoalProgressReport(ctx context.Context, req *pb.GetGoalProgressReportRequest) (*pb.GoalProgressReportResponse, error) {
 	s.logger.Info("GetGoalProgressReport")
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.reportstorage.GetGoalProgressReport(ctx, req)
 }
@@ -10,6 +10,7 @@ type Service struct {
 	AccountService      *AccountService
 	BudgetService       *BudgetService
 	CategoryService     *CategoryService
+	CurrencyService     *CurrencyService
 	GoalService         *GoalService
 	NotificationService *NotificationService
 	ReportService       *ReportService
@@ -18,13 +19,14 @@ type Service struct {
 
 func New(storage storage.StrorageI, logger *slog.Logger) *Service {
 	return &Service{
-		AccountService:      NewAccountService(storage.Account(), logger),
+		AccountService:      NewAccountService(storage.Account(), storage.Goal(), storage.Notification(), logger),
 		BudgetService:       NewBudgetService(storage.Budget(), logger),
 		CategoryService:     NewCategoryService(storage.Category(), logger),
-		GoalService:         NewGoalService(storage.Goal(), logger),
+		CurrencyService:     NewCurrencyService(storage.Currency(), logger),
+		GoalService:         NewGoalService(storage.Goal(), storage.Notification(), logger),
 		NotificationService: NewNotificationService(storage.Notification(), logger),
 		ReportService:       NewReportService(storage.Report(), logger),
-		TransactionService:  NewTransactionService(storage.Transaction(), logger),
+		TransactionService:  NewTransactionService(storage.Transaction(), storage.RecurringTransaction(), storage.Rule(), storage.Budget(), storage.Goal(), storage.Notification(), logger),
 	}
 
 }
@@ -1,46 +1,450 @@
 package service
 
 import (
+	notification_pb "budgeting-service/genproto/notification"
 	pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/exporter"
 	"budgeting-service/internal/items/repository"
+	"bufio"
 	"context"
 	"log/slog"
 )
 
+// exportChunkSize is how much of an export is buffered before it is sent as
+// one stream message.
+const exportChunkSize = 32 * 1024
+
 type TransactionService struct {
 	pb.UnimplementedTransactionServiceServer
-	transactionstorage repository.TransactionI
-	logger             *slog.Logger
+	transactionstorage  repository.TransactionI
+	recurringstorage    repository.RecurringTransactionI
+	rulestorage         repository.RuleI
+	budgetstorage       repository.BudgetI
+	goalstorage         repository.GoalI
+	notificationstorage repository.NotificationI
+	logger              *slog.Logger
 }
 
-func NewTransactionService(transactionstorage repository.TransactionI, logger *slog.Logger) *TransactionService {
+func NewTransactionService(transactionstorage repository.TransactionI, recurringstorage repository.RecurringTransactionI, rulestorage repository.RuleI, budgetstorage repository.BudgetI, goalstorage repository.GoalI, notificationstorage repository.NotificationI, logger *slog.Logger) *TransactionService {
 	return &TransactionService{
-		transactionstorage: transactionstorage,
-		logger:             logger,
+		transactionstorage:  transactionstorage,
+		recurringstorage:    recurringstorage,
+		rulestorage:         rulestorage,
+		budgetstorage:       budgetstorage,
+		goalstorage:         goalstorage,
+		notificationstorage: notificationstorage,
+		logger:              logger,
 	}
 }
 
 func (s *TransactionService) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.TransactionResponse, error) {
 	s.logger.Info("CreateTransaction", slog.Any("req", req))
-	return s.transactionstorage.CreateTransaction(ctx, req)
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateTransaction(req); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.transactionstorage.CreateTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.budgetAlerts(ctx, transaction.Id)
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, transaction.UserId)
+
+	return transaction, nil
 }
 
 func (s *TransactionService) GetTransactions(ctx context.Context, req *pb.GetTransactionsRequest) (*pb.TransactionsResponse, error) {
 	s.logger.Info("GetTransactions", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
 	return s.transactionstorage.GetTransactions(ctx, req)
 }
 
 func (s *TransactionService) GetTransactionById(ctx context.Context, req *pb.GetTransactionByIdRequest) (*pb.TransactionResponse, error) {
 	s.logger.Info("GetTransactionById", slog.String("id", req.Id))
-	return s.transactionstorage.GetTransactionById(ctx, req)
+	return s.ownedTransaction(ctx, req.Id)
 }
 
 func (s *TransactionService) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.TransactionResponse, error) {
 	s.logger.Info("UpdateTransaction", slog.Any("req", req))
-	return s.transactionstorage.UpdateTransaction(ctx, req)
+
+	if err := validateUpdateTransaction(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedTransaction(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.transactionstorage.UpdateTransaction(ctx, req)
+	if err != nil || transaction == nil {
+		return transaction, err
+	}
+	s.budgetAlerts(ctx, transaction.Id)
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, transaction.UserId)
+
+	return transaction, nil
 }
 
 func (s *TransactionService) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.Empty, error) {
 	s.logger.Info("DeleteTransaction", slog.String("id", req.Id))
-	return s.transactionstorage.DeleteTransaction(ctx, req)
+
+	transaction, err := s.ownedTransaction(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.transactionstorage.DeleteTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, transaction.UserId)
+
+	return resp, nil
+}
+
+func (s *TransactionService) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.TransferResponse, error) {
+	s.logger.Info("CreateTransfer", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateTransfer(req); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.transactionstorage.CreateTransfer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, userID)
+
+	return transfer, nil
+}
+
+func (s *TransactionService) ImportTransactions(ctx context.Context, req *pb.ImportTransactionsRequest) (*pb.ImportTransactionsResponse, error) {
+	s.logger.Info("ImportTransactions", slog.String("user_id", req.UserId), slog.String("account_id", req.AccountId))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	imported, err := s.transactionstorage.ImportTransactions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, userID)
+
+	return imported, nil
+}
+
+func (s *TransactionService) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportTransactionsResponse, error) {
+	s.logger.Info("ImportStatement", slog.String("user_id", req.UserId), slog.String("account_id", req.AccountId), slog.String("format", req.Format))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	imported, err := s.transactionstorage.ImportStatement(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshGoals(ctx, s.goalstorage, s.notificationstorage, s.logger, userID)
+
+	return imported, nil
+}
+
+func (s *TransactionService) ExportTransactions(req *pb.ExportTransactionsRequest, stream pb.TransactionService_ExportTransactionsServer) error {
+	s.logger.Info("ExportTransactions", slog.Any("req", req))
+
+	userID, err := requestUser(stream.Context(), req.UserId)
+	if err != nil {
+		return err
+	}
+	req.UserId = userID
+
+	out := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	writer, err := exporter.NewWriter(req.Format, out)
+	if err != nil {
+		return err
+	}
+
+	err = s.transactionstorage.ExportTransactions(stream.Context(), req, exporter.GroupsByAccount(req.Format), writer.Write)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// chunkWriter sends everything written to it as ExportTransactionsChunk
+// messages.
+type chunkWriter struct {
+	stream pb.TransactionService_ExportTransactionsServer
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportTransactionsChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *TransactionService) CreateRecurringTransaction(ctx context.Context, req *pb.CreateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("CreateRecurringTransaction", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateCreateRecurringTransaction(req); err != nil {
+		return nil, err
+	}
+
+	return s.recurringstorage.CreateRecurringTransaction(ctx, req)
+}
+
+func (s *TransactionService) GetRecurringTransactions(ctx context.Context, req *pb.GetRecurringTransactionsRequest) (*pb.RecurringTransactionsResponse, error) {
+	s.logger.Info("GetRecurringTransactions", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.recurringstorage.GetRecurringTransactions(ctx, req)
+}
+
+func (s *TransactionService) GetRecurringTransactionById(ctx context.Context, req *pb.GetRecurringTransactionByIdRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("GetRecurringTransactionById", slog.String("id", req.Id))
+	return s.ownedRecurringTransaction(ctx, req.Id)
+}
+
+func (s *TransactionService) UpdateRecurringTransaction(ctx context.Context, req *pb.UpdateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("UpdateRecurringTransaction", slog.Any("req", req))
+
+	if err := validateUpdateRecurringTransaction(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedRecurringTransaction(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return s.recurringstorage.UpdateRecurringTransaction(ctx, req)
+}
+
+func (s *TransactionService) DeleteRecurringTransaction(ctx context.Context, req *pb.DeleteRecurringTransactionRequest) (*pb.Empty, error) {
+	s.logger.Info("DeleteRecurringTransaction", slog.String("id", req.Id))
+
+	if _, err := s.ownedRecurringTransaction(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return s.recurringstorage.DeleteRecurringTransaction(ctx, req)
+}
+
+func (s *TransactionService) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("CreateRule", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateRule(req.Name, req.Conditions, req.Actions); err != nil {
+		return nil, err
+	}
+
+	return s.rulestorage.CreateRule(ctx, req)
+}
+
+func (s *TransactionService) GetRules(ctx context.Context, req *pb.GetRulesRequest) (*pb.RulesResponse, error) {
+	s.logger.Info("GetRules", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	return s.rulestorage.GetRules(ctx, req)
+}
+
+func (s *TransactionService) GetRuleById(ctx context.Context, req *pb.GetRuleByIdRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("GetRuleById", slog.String("id", req.Id))
+	return s.ownedRule(ctx, req.Id)
+}
+
+func (s *TransactionService) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("UpdateRule", slog.Any("req", req))
+
+	if err := validateUpdateRule(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ownedRule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.rulestorage.UpdateRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, notFound("rule")
+	}
+
+	return rule, nil
+}
+
+func (s *TransactionService) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.Empty, error) {
+	s.logger.Info("DeleteRule", slog.String("id", req.Id))
+
+	if _, err := s.ownedRule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return s.rulestorage.DeleteRule(ctx, req)
+}
+
+func (s *TransactionService) PreviewRules(ctx context.Context, req *pb.PreviewRulesRequest) (*pb.PreviewRulesResponse, error) {
+	s.logger.Info("PreviewRules", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validatePreviewRules(req); err != nil {
+		return nil, err
+	}
+
+	return s.rulestorage.PreviewRules(ctx, req)
+}
+
+func (s *TransactionService) ApplyRules(ctx context.Context, req *pb.ApplyRulesRequest) (*pb.ApplyRulesResponse, error) {
+	s.logger.Info("ApplyRules", slog.Any("req", req))
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID
+
+	if err := validateApplyRules(req); err != nil {
+		return nil, err
+	}
+
+	response, err := s.rulestorage.ApplyRules(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// Moving spending between categories can push budgets past their alert
+	// thresholds. Goals are left alone: their progress comes from accounts
+	// and contributions, which rules do not touch.
+	for _, id := range response.RecategorizedIds {
+		s.budgetAlerts(ctx, id)
+	}
+
+	return response, nil
+}
+
+// ownedTransaction loads a transaction and checks that the caller may touch it.
+func (s *TransactionService) ownedTransaction(ctx context.Context, id string) (*pb.TransactionResponse, error) {
+	transaction, err := s.transactionstorage.GetTransactionById(ctx, &pb.GetTransactionByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, notFound("transaction")
+	}
+	if err := authorizeOwner(ctx, transaction.UserId, "transaction"); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// ownedRecurringTransaction loads a recurring transaction and checks that the
+// caller may touch it.
+func (s *TransactionService) ownedRecurringTransaction(ctx context.Context, id string) (*pb.RecurringTransactionResponse, error) {
+	recurring, err := s.recurringstorage.GetRecurringTransactionById(ctx, &pb.GetRecurringTransactionByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil {
+		return nil, notFound("recurring transaction")
+	}
+	if err := authorizeOwner(ctx, recurring.UserId, "recurring transaction"); err != nil {
+		return nil, err
+	}
+
+	return recurring, nil
+}
+
+// ownedRule loads a rule and checks that the caller may touch it.
+func (s *TransactionService) ownedRule(ctx context.Context, id string) (*pb.RuleResponse, error) {
+	rule, err := s.rulestorage.GetRuleById(ctx, &pb.GetRuleByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, notFound("rule")
+	}
+	if err := authorizeOwner(ctx, rule.UserId, "rule"); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// budgetAlerts notifies the user of every budget threshold a transaction has
+// pushed their spending past. The transaction is already saved, so a failure
+// here is logged rather than returned.
+func (s *TransactionService) budgetAlerts(ctx context.Context, transactionID string) {
+	alerts, err := s.budgetstorage.CheckBudgetAlerts(ctx, transactionID)
+	if err != nil {
+		s.logger.Error("Error checking budget alerts", slog.String("transaction_id", transactionID), slog.Any("error", err))
+		return
+	}
+
+	for _, alert := range alerts {
+		_, err := s.notificationstorage.CreateNotification(ctx, &notification_pb.CreateNotificationRequest{
+			UserId:  alert.UserID,
+			Message: alert.Message(),
+		})
+		if err != nil {
+			s.logger.Error("Error creating budget alert", slog.String("budget_id", alert.BudgetID), slog.Any("error", err))
+			continue
+		}
+		s.logger.Info("Budget alert sent", slog.String("budget_id", alert.BudgetID), slog.String("threshold", alert.Threshold))
+	}
 }
@@ -0,0 +1,254 @@
+package service
+
+import (
+	account_pb "budgeting-service/genproto/account"
+	budget_pb "budgeting-service/genproto/budget"
+	category_pb "budgeting-service/genproto/category"
+	transaction_pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/budgetperiod"
+	"budgeting-service/internal/items/recurrence"
+	"budgeting-service/internal/items/validation"
+	"fmt"
+)
+
+// The checks below only look at the request itself. Whether the ids it
+// refers to exist, belong to the caller and fit together is checked by the
+// storage, which reports it the same way.
+
+var transactionTypes = []string{validation.Income, validation.Expense}
+
+func validateCreateTransaction(req *transaction_pb.CreateTransactionRequest) error {
+	var v validation.Violations
+	v.ID("account_id", req.AccountId)
+	v.OptionalID("category_id", req.CategoryId)
+	v.OptionalID("goal_id", req.GoalId)
+	v.Positive("amount", req.Amount)
+	v.OneOf("type", req.Type, transactionTypes...)
+	v.Date("date", req.Date)
+	validateSplits(&v, req.Splits)
+	return v.Err()
+}
+
+func validateUpdateTransaction(req *transaction_pb.UpdateTransactionRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.OptionalID("account_id", req.AccountId)
+	v.OptionalID("category_id", req.CategoryId)
+	v.OptionalID("goal_id", req.GoalId)
+	v.OptionalPositive("amount", req.Amount)
+	v.OptionalOneOf("type", req.Type, transactionTypes...)
+	v.OptionalDate("date", req.Date)
+	validateSplits(&v, req.Splits)
+	if req.ClearSplits && len(req.Splits) > 0 {
+		v.Add("clear_splits", "cannot be set together with splits")
+	}
+	return v.Err()
+}
+
+func validateSplits(v *validation.Violations, splits []*transaction_pb.Split) {
+	for i, split := range splits {
+		v.ID(fmt.Sprintf("splits[%d].category_id", i), split.CategoryId)
+		v.Positive(fmt.Sprintf("splits[%d].amount", i), split.Amount)
+	}
+}
+
+func validateCreateTransfer(req *transaction_pb.CreateTransferRequest) error {
+	var v validation.Violations
+	v.ID("from_account_id", req.FromAccountId)
+	v.ID("to_account_id", req.ToAccountId)
+	if req.FromAccountId != "" && req.FromAccountId == req.ToAccountId {
+		v.Add("to_account_id", "must differ from from_account_id")
+	}
+	v.Positive("amount", req.Amount)
+	v.OptionalPositive("to_amount", req.ToAmount)
+	if req.ExchangeRate < 0 {
+		v.Add("exchange_rate", "must not be negative")
+	}
+	v.Date("date", req.Date)
+	return v.Err()
+}
+
+func validateCreateRecurringTransaction(req *transaction_pb.CreateRecurringTransactionRequest) error {
+	var v validation.Violations
+	v.ID("account_id", req.AccountId)
+	v.OptionalID("category_id", req.CategoryId)
+	v.Positive("amount", req.Amount)
+	v.OneOf("type", req.Type, transactionTypes...)
+	v.OneOf("frequency", req.Frequency, recurrence.Frequencies...)
+	validateSchedule(&v, req.Interval, req.DayOfMonth)
+	start := v.Date("start_date", req.StartDate)
+	v.NotBefore("end_date", v.OptionalDate("end_date", req.EndDate), "start_date", start)
+	return v.Err()
+}
+
+func validateUpdateRecurringTransaction(req *transaction_pb.UpdateRecurringTransactionRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.OptionalID("account_id", req.AccountId)
+	v.OptionalID("category_id", req.CategoryId)
+	v.OptionalPositive("amount", req.Amount)
+	v.OptionalOneOf("frequency", req.Frequency, recurrence.Frequencies...)
+	validateSchedule(&v, req.Interval, req.DayOfMonth)
+	v.OptionalDate("end_date", req.EndDate)
+	return v.Err()
+}
+
+func validateSchedule(v *validation.Violations, interval, dayOfMonth int32) {
+	if interval < 0 {
+		v.Add("interval", "must not be negative")
+	}
+	if dayOfMonth < 0 || dayOfMonth > 31 {
+		v.Add("day_of_month", "must be between 1 and 31")
+	}
+}
+
+func validateCreateCategory(req *category_pb.CreateCategoryRequest) error {
+	var v validation.Violations
+	v.Required("name", req.Name)
+	if req.ParentId == "" {
+		v.OneOf("type", req.Type, transactionTypes...)
+	} else {
+		v.ID("parent_id", req.ParentId)
+		v.OptionalOneOf("type", req.Type, transactionTypes...)
+	}
+	return v.Err()
+}
+
+func validateUpdateCategory(req *category_pb.UpdateCategoryRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.OptionalOneOf("type", req.Type, transactionTypes...)
+	return v.Err()
+}
+
+// validateID checks a request that names a document by id, plus an optional
+// id to hand its contents over to.
+func validateID(field, id, optionalField, optionalID string) error {
+	var v validation.Violations
+	v.ID(field, id)
+	if optionalField != "" {
+		v.OptionalID(optionalField, optionalID)
+	}
+	return v.Err()
+}
+
+func validateMergeCategories(req *category_pb.MergeCategoriesRequest) error {
+	var v validation.Violations
+	v.ID("source_id", req.SourceId)
+	v.ID("target_id", req.TargetId)
+	if req.SourceId != "" && req.SourceId == req.TargetId {
+		v.Add("target_id", "must differ from source_id")
+	}
+	return v.Err()
+}
+
+func validateCreateBudget(req *budget_pb.CreateBudgetRequest) error {
+	var v validation.Violations
+	v.ID("category_id", req.CategoryId)
+	v.Positive("amount", req.Amount)
+	v.OneOf("period", req.Period, budgetperiod.Periods...)
+	start := v.Date("start_date", req.StartDate)
+	v.NotBefore("end_date", v.Date("end_date", req.EndDate), "start_date", start)
+	return v.Err()
+}
+
+func validateUpdateBudget(req *budget_pb.UpdateBudgetRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.OptionalPositive("amount", req.Amount)
+	v.OptionalOneOf("period", req.Period, budgetperiod.Periods...)
+	start := v.OptionalDate("start_date", req.StartDate)
+	v.NotBefore("end_date", v.OptionalDate("end_date", req.EndDate), "start_date", start)
+	return v.Err()
+}
+
+func validateAssignToEnvelope(req *budget_pb.AssignToEnvelopeRequest) error {
+	var v validation.Violations
+	v.ID("category_id", req.CategoryId)
+	if amount, ok := v.Money("amount", req.Amount); ok && amount == 0 {
+		v.Add("amount", "must not be zero")
+	}
+	return v.Err()
+}
+
+func validateMoveBetweenEnvelopes(req *budget_pb.MoveBetweenEnvelopesRequest) error {
+	var v validation.Violations
+	v.ID("from_category_id", req.FromCategoryId)
+	v.ID("to_category_id", req.ToCategoryId)
+	if req.FromCategoryId != "" && req.FromCategoryId == req.ToCategoryId {
+		v.Add("to_category_id", "must differ from from_category_id")
+	}
+	v.Positive("amount", req.Amount)
+	return v.Err()
+}
+
+func validateCreateAccount(req *account_pb.CreateAccountRequest) error {
+	var v validation.Violations
+	v.Required("name", req.Name)
+	v.OneOf("type", req.Type, validation.AccountTypes...)
+	v.Currency("currency", req.Currency)
+	if req.Balance != nil {
+		v.Money("balance", req.Balance)
+	}
+	return v.Err()
+}
+
+func validateUpdateAccount(req *account_pb.UpdateAccountRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.OptionalOneOf("type", req.Type, validation.AccountTypes...)
+	if req.Currency != "" {
+		v.Currency("currency", req.Currency)
+	}
+	if req.Balance != nil {
+		v.Money("balance", req.Balance)
+	}
+	return v.Err()
+}
+
+func validateRule(name string, conditions *transaction_pb.RuleConditions, actions *transaction_pb.RuleActions) error {
+	var v validation.Violations
+	v.Required("name", name)
+	validateRuleParts(&v, conditions, actions)
+	return v.Err()
+}
+
+func validateUpdateRule(req *transaction_pb.UpdateRuleRequest) error {
+	var v validation.Violations
+	v.ID("id", req.Id)
+	v.Required("name", req.Name)
+	validateRuleParts(&v, req.Conditions, req.Actions)
+	return v.Err()
+}
+
+// validateRuleParts checks the ids and the type a rule names; the storage
+// checks that the rule as a whole makes sense.
+func validateRuleParts(v *validation.Violations, conditions *transaction_pb.RuleConditions, actions *transaction_pb.RuleActions) {
+	v.OptionalID("conditions.account_id", conditions.GetAccountId())
+	v.OptionalOneOf("conditions.type", conditions.GetType(), transactionTypes...)
+	v.OptionalID("actions.category_id", actions.GetCategoryId())
+}
+
+func validatePreviewRules(req *transaction_pb.PreviewRulesRequest) error {
+	var v validation.Violations
+	if req.Conditions != nil || req.Actions != nil {
+		validateRuleParts(&v, req.Conditions, req.Actions)
+	}
+	validateRuleScope(&v, req.Scope)
+	return v.Err()
+}
+
+func validateApplyRules(req *transaction_pb.ApplyRulesRequest) error {
+	var v validation.Violations
+	validateRuleScope(&v, req.Scope)
+	return v.Err()
+}
+
+func validateRuleScope(v *validation.Violations, scope *transaction_pb.RuleScope) {
+	for i, id := range scope.GetRuleIds() {
+		v.ID(fmt.Sprintf("scope.rule_ids[%d]", i), id)
+	}
+	v.OptionalID("scope.account_id", scope.GetAccountId())
+	start := v.OptionalDate("scope.start_date", scope.GetStartDate())
+	v.NotBefore("scope.end_date", v.OptionalDate("scope.end_date", scope.GetEndDate()), "scope.start_date", start)
+}
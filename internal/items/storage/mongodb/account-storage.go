@@ -3,9 +3,13 @@ package mongodb
 import (
 	pb "budgeting-service/genproto/account"
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/money"
 	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/validation"
 	"context"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -34,13 +38,20 @@ func (s *AccountStorage) CreateAccount(ctx context.Context, req *pb.CreateAccoun
 	s.logger.Info("CreateAccount", "req", req)
 	accountCollection := s.mongodb.Collection("accounts")
 	created_at := time.Now()
+	currency := currencyCode(req.Currency)
+
+	balance, err := money.Amount(req.Balance, currency)
+	if err != nil {
+		s.logger.Error("invalid account balance", slog.Any("error", err))
+		return nil, validation.Field("balance", "%v", err)
+	}
 
 	accountDoc := bson.D{
 		{Key: "user_id", Value: req.UserId},
 		{Key: "name", Value: req.Name},
 		{Key: "type", Value: req.Type},
-		{Key: "balance", Value: req.Balance},
-		{Key: "currency", Value: req.Currency},
+		{Key: "balance", Value: balance},
+		{Key: "currency", Value: currency},
 		{Key: "created_at", Value: created_at},
 		{Key: "updated_at", Value: created_at},
 		{Key: "deleted_at", Value: nil},
@@ -59,8 +70,8 @@ func (s *AccountStorage) CreateAccount(ctx context.Context, req *pb.CreateAccoun
 		UserId:    req.UserId,
 		Name:      req.Name,
 		Type:      req.Type,
-		Balance:   req.Balance,
-		Currency:  req.Currency,
+		Balance:   money.ToProto(balance, currency),
+		Currency:  currency,
 		CreatedAt: created_at.String(),
 	}
 
@@ -71,40 +82,65 @@ func (s *AccountStorage) GetAccounts(ctx context.Context, req *pb.GetAccountsReq
 	s.logger.Info("GetAccounts", "req", req)
 	accountCollection := s.mongodb.Collection("accounts")
 
-	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if req.Type != "" {
+		filter = append(filter, bson.E{Key: "type", Value: req.Type})
+	}
+	if req.Currency != "" {
+		filter = append(filter, bson.E{Key: "currency", Value: currencyCode(req.Currency)})
+	}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(req.Search)},
+			{Key: "$options", Value: "i"},
+		}})
+	}
 
-	cursor, err := accountCollection.Find(ctx, filter)
+	page, err := newPage(req.SortBy, req.SortOrder, map[string]string{
+		"name":       "name",
+		"balance":    "balance",
+		"created_at": "created_at",
+	}, "created_at", req.PageSize, req.PageToken)
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var accounts []*pb.AccountResponse
-	for cursor.Next(ctx) {
-		var account bson.M
-		if err = cursor.Decode(&account); err != nil {
-			s.logger.Error(err.Error())
-			return nil, err
-		}
+	cursor, err := accountCollection.Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var docs []bson.M
+	if err = cursor.All(ctx, &docs); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
 
+	var accounts []*pb.AccountResponse
+	for _, account := range docs {
 		accounts = append(accounts, &pb.AccountResponse{
 			Id:        account["_id"].(primitive.ObjectID).Hex(),
 			UserId:    account["user_id"].(string),
 			Name:      account["name"].(string),
 			Type:      account["type"].(string),
-			Balance:   float32(account["balance"].(float64)),
+			Balance:   money.ToProto(storedAmount(account["balance"]), account["currency"].(string)),
 			Currency:  account["currency"].(string),
 			CreatedAt: account["created_at"].(primitive.DateTime).Time().String(),
 		})
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error(err.Error())
-		return nil, err
-	}
-
-	return &pb.AccountsResponse{Accounts: accounts}, nil
+	return &pb.AccountsResponse{Accounts: accounts, NextPageToken: nextPageToken}, nil
 }
 
 func (s *AccountStorage) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRequest) (*pb.AccountResponse, error) {
@@ -137,7 +173,7 @@ func (s *AccountStorage) GetAccountById(ctx context.Context, req *pb.GetAccountB
 		UserId:    account["user_id"].(string),
 		Name:      account["name"].(string),
 		Type:      account["type"].(string),
-		Balance:   float32(account["balance"].(float64)),
+		Balance:   money.ToProto(storedAmount(account["balance"]), account["currency"].(string)),
 		Currency:  account["currency"].(string),
 		CreatedAt: account["created_at"].(primitive.DateTime).Time().String(),
 	}, nil
@@ -164,11 +200,16 @@ func (s *AccountStorage) UpdateAccount(ctx context.Context, req *pb.UpdateAccoun
 	if req.Type != "" {
 		updateFields = append(updateFields, bson.E{Key: "type", Value: req.Type})
 	}
-	if req.Balance != 0 {
-		updateFields = append(updateFields, bson.E{Key: "balance", Value: req.Balance})
+	if req.Balance != nil {
+		balance, err := money.Amount(req.Balance, currencyCode(req.Currency))
+		if err != nil {
+			s.logger.Error("invalid account balance", slog.Any("error", err))
+			return nil, validation.Field("balance", "%v", err)
+		}
+		updateFields = append(updateFields, bson.E{Key: "balance", Value: balance})
 	}
 	if req.Currency != "" {
-		updateFields = append(updateFields, bson.E{Key: "currency", Value: req.Currency})
+		updateFields = append(updateFields, bson.E{Key: "currency", Value: currencyCode(req.Currency)})
 	}
 
 	if len(updateFields) > 0 {
@@ -203,7 +244,7 @@ func (s *AccountStorage) UpdateAccount(ctx context.Context, req *pb.UpdateAccoun
 		UserId:    updatedAccount["user_id"].(string),
 		Name:      updatedAccount["name"].(string),
 		Type:      updatedAccount["type"].(string),
-		Balance:   float32(updatedAccount["balance"].(float64)),
+		Balance:   money.ToProto(storedAmount(updatedAccount["balance"]), updatedAccount["currency"].(string)),
 		Currency:  updatedAccount["currency"].(string),
 		CreatedAt: updatedAccount["created_at"].(primitive.DateTime).Time().String(),
 		UpdatedAt: updatedAccount["updated_at"].(primitive.DateTime).Time().String(),
@@ -234,3 +275,9 @@ func (s *AccountStorage) DeleteAccount(ctx context.Context, req *pb.DeleteAccoun
 
 	return &pb.Empty{}, nil
 }
+
+// currencyCode writes a currency code the way rates and accounts store it,
+// trimmed and upper case.
+func currencyCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
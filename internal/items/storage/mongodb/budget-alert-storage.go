@@ -0,0 +1,267 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"budgeting-service/internal/items/budgetalert"
+	"budgeting-service/internal/items/exchange"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CheckBudgetAlerts looks at the budgets an expense counts against and claims
+// every alert threshold their spending has reached. A threshold is claimed
+// with a conditional update on the budget, so it fires at most once per
+// period however many requests race past it. When one expense crosses several
+// thresholds at once only the highest is returned; the lower ones are claimed
+// without an alert of their own.
+func (s *BudgetStorage) CheckBudgetAlerts(ctx context.Context, transactionID string) ([]*budgetalert.Alert, error) {
+	objID, err := primitive.ObjectIDFromHex(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transaction struct {
+		reportTransaction `bson:",inline"`
+		UserID            string `bson:"user_id"`
+		Type              string `bson:"type"`
+	}
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	err = s.mongodb.Collection("transactions").FindOne(ctx, filter).Decode(&transaction)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		s.logger.Error("Error while retrieving transaction", slog.Any("error", err))
+		return nil, err
+	}
+	if transaction.Type != "expense" {
+		return nil, nil
+	}
+
+	// A budget on a parent category covers spending in its subcategories.
+	tree, err := userCategoryTree(ctx, s.mongodb, transaction.UserID)
+	if err != nil {
+		s.logger.Error("Error while retrieving categories", slog.Any("error", err))
+		return nil, err
+	}
+
+	var categoryIDs bson.A
+	for _, part := range transaction.categoryAmounts() {
+		for _, categoryID := range tree.Ancestors(part.CategoryID) {
+			categoryIDs = append(categoryIDs, categoryID)
+		}
+	}
+
+	cursor, err := s.mongodb.Collection("budgets").Find(ctx, bson.D{
+		{Key: "user_id", Value: transaction.UserID},
+		{Key: "category_id", Value: bson.D{{Key: "$in", Value: categoryIDs}}},
+		{Key: "start_date", Value: bson.D{{Key: "$lte", Value: transaction.Date}}},
+		{Key: "end_date", Value: bson.D{{Key: "$gte", Value: transaction.Date}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	if err != nil {
+		s.logger.Error("Error while retrieving budgets", slog.Any("error", err))
+		return nil, err
+	}
+
+	var budgets []bson.M
+	if err = cursor.All(ctx, &budgets); err != nil {
+		s.logger.Error("Error while decoding budget", slog.Any("error", err))
+		return nil, err
+	}
+
+	var alerts []*budgetalert.Alert
+	for _, budget := range budgets {
+		alert, err := s.budgetAlert(ctx, budget)
+		if err != nil {
+			s.logger.Error("Error while checking budget alerts", slog.Any("error", err))
+			return nil, err
+		}
+		if alert != nil {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	return alerts, nil
+}
+
+func (s *BudgetStorage) budgetAlert(ctx context.Context, budget bson.M) (*budgetalert.Alert, error) {
+	budgetID := budget["_id"].(primitive.ObjectID)
+	alert := &budgetalert.Alert{
+		BudgetID:    budgetID.Hex(),
+		UserID:      budget["user_id"].(string),
+		Limit:       storedAmount(budget["amount"]) + storedAmount(budget["carry_over"]),
+		Currency:    storedString(budget["currency"]),
+		PeriodStart: budget["start_date"].(primitive.DateTime).Time().UTC(),
+		PeriodEnd:   budget["end_date"].(primitive.DateTime).Time().UTC(),
+	}
+	categoryID := budget["category_id"].(string)
+
+	categoryIDs, err := budgetCategories(ctx, s.mongodb, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := exchange.NewConverter(alert.Currency, rateLookup(s.mongodb))
+	spent, err := categorySpent(ctx, s.mongodb, alert.UserID, categoryIDs, alert.PeriodStart, alert.PeriodEnd, converter)
+	if err != nil {
+		return nil, err
+	}
+	alert.Spent = spent
+
+	settings := budgetAlerts(budget)
+	for _, threshold := range budgetalert.Crossed(spent, alert.Limit, settings.Thresholds, settings.OverBudget) {
+		res, err := s.mongodb.Collection("budgets").UpdateOne(ctx, bson.D{
+			{Key: "_id", Value: budgetID},
+			{Key: "alerts_fired", Value: bson.D{{Key: "$ne", Value: budgetalert.Key(alert.PeriodStart, threshold)}}},
+		}, bson.D{
+			{Key: "$push", Value: bson.D{{Key: "alerts_fired", Value: budgetalert.Key(alert.PeriodStart, threshold)}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res.ModifiedCount == 1 {
+			alert.Threshold = threshold
+		}
+	}
+	if alert.Threshold == "" {
+		return nil, nil
+	}
+
+	alert.CategoryName, err = lookupCategoryName(ctx, s.mongodb, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// lookupCategoryName returns the name of a category, or "" when it is gone.
+func lookupCategoryName(ctx context.Context, db *mongo.Database, categoryID string) (string, error) {
+	objID, err := primitive.ObjectIDFromHex(categoryID)
+	if err != nil {
+		return "", nil
+	}
+
+	var category struct {
+		Name string `bson:"name"`
+	}
+	err = db.Collection("categories").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&category)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return "", err
+	}
+	return category.Name, nil
+}
+
+// budgetCategories returns the categories whose spending counts against a
+// budget on categoryID: the category itself and all of its subcategories.
+func budgetCategories(ctx context.Context, db *mongo.Database, categoryID string) ([]string, error) {
+	objID, err := primitive.ObjectIDFromHex(categoryID)
+	if err != nil {
+		return []string{categoryID}, nil
+	}
+
+	var category struct {
+		UserID string `bson:"user_id"`
+	}
+	err = db.Collection("categories").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return []string{categoryID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := userCategoryTree(ctx, db, category.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Descendants(categoryID), nil
+}
+
+// categoriesFilter matches transactions booked to any of the categories,
+// either directly or through one of their splits.
+func categoriesFilter(categoryIDs []string) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "category_id", Value: bson.D{{Key: "$in", Value: categoryIDs}}}},
+		bson.D{{Key: "splits.category_id", Value: bson.D{{Key: "$in", Value: categoryIDs}}}},
+	}}
+}
+
+// categorySpent adds up a user's expenses in the categories between two
+// dates, inclusive, converted into the converter's currency. Only the splits
+// of a transaction that belong to the categories are counted.
+func categorySpent(ctx context.Context, db *mongo.Database, userID string, categoryIDs []string, start, end time.Time, converter *exchange.Converter) (int64, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "type", Value: "expense"},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lte", Value: end}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		categoriesFilter(categoryIDs),
+	}
+	return sumTransactions(ctx, db, filter, categoryIDs, converter)
+}
+
+// sumTransactions adds up the transactions matching filter, converted into the
+// converter's currency. Given categories, only the parts of each transaction
+// booked to them are counted.
+func sumTransactions(ctx context.Context, db *mongo.Database, filter bson.D, categoryIDs []string, converter *exchange.Converter) (int64, error) {
+	var total int64
+	err := eachAmount(ctx, db, filter, categoryIDs, converter, func(_ string, _ time.Time, amount int64) {
+		total += amount
+	})
+	return total, err
+}
+
+// eachAmount calls fn with the category, date and converted amount of every
+// part of the transactions matching filter, skipping parts outside
+// categoryIDs when it is not empty.
+func eachAmount(ctx context.Context, db *mongo.Database, filter bson.D, categoryIDs []string, converter *exchange.Converter, fn func(categoryID string, date time.Time, amount int64)) error {
+	projection := bson.D{
+		{Key: "category_id", Value: 1},
+		{Key: "amount", Value: 1},
+		{Key: "currency", Value: 1},
+		{Key: "date", Value: 1},
+		{Key: "splits", Value: 1},
+	}
+
+	included := make(map[string]bool, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		included[categoryID] = true
+	}
+
+	cursor, err := db.Collection("transactions").Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var transaction reportTransaction
+		if err := cursor.Decode(&transaction); err != nil {
+			return err
+		}
+		for _, part := range transaction.categoryAmounts() {
+			if len(included) > 0 && !included[part.CategoryID] {
+				continue
+			}
+			amount, err := converter.Convert(ctx, part.Amount, transaction.Currency, transaction.Date)
+			if err != nil {
+				return err
+			}
+			fn(part.CategoryID, transaction.Date, amount)
+		}
+	}
+
+	return cursor.Err()
+}
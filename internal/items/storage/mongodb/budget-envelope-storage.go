@@ -0,0 +1,342 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/budget"
+	"budgeting-service/internal/items/budgetperiod"
+	"budgeting-service/internal/items/envelope"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/money"
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Envelopes are monthly budgets flagged with envelope: true, one per user,
+// category and month, whose amount is the money assigned to the category
+// that month. Money left in an envelope stays there from month to month, and
+// income not yet assigned to any envelope is "to be budgeted". Both are
+// counted from the user's first envelope month, so history from before the
+// user switched to envelopes does not weigh on them. Money can only be moved
+// out of an envelope as far as it holds some; assigning can take an envelope
+// below zero, which is how an overspent envelope is taken back.
+
+func (s *BudgetStorage) AssignToEnvelope(ctx context.Context, req *pb.AssignToEnvelopeRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("AssignToEnvelope", slog.String("req", req.String()))
+
+	month, err := envelope.Month(req.Month, time.Now())
+	if err != nil {
+		s.logger.Error("Invalid envelope month", slog.Any("error", err))
+		return nil, err
+	}
+
+	currency, err := s.envelopeCurrency(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := money.Amount(req.Amount, currency)
+	if err != nil {
+		s.logger.Error("Invalid envelope amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+	if amount == 0 {
+		return nil, validation.Field("amount", "must not be zero")
+	}
+
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		return s.assignToEnvelope(sc, req.UserId, req.CategoryId, "category_id", month, currency, amount)
+	})
+	if err != nil {
+		s.logger.Error("Error while assigning to envelope", slog.Any("error", err))
+		return nil, err
+	}
+
+	return s.GetEnvelopeSummary(ctx, &pb.GetEnvelopeSummaryRequest{UserId: req.UserId, Month: req.Month})
+}
+
+func (s *BudgetStorage) MoveBetweenEnvelopes(ctx context.Context, req *pb.MoveBetweenEnvelopesRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("MoveBetweenEnvelopes", slog.String("req", req.String()))
+
+	if req.FromCategoryId == req.ToCategoryId {
+		return nil, validation.Field("to_category_id", "must differ from from_category_id")
+	}
+
+	month, err := envelope.Month(req.Month, time.Now())
+	if err != nil {
+		s.logger.Error("Invalid envelope month", slog.Any("error", err))
+		return nil, err
+	}
+
+	currency, err := s.envelopeCurrency(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := money.Amount(req.Amount, currency)
+	if err != nil {
+		s.logger.Error("Invalid envelope amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+	if amount <= 0 {
+		return nil, validation.Field("amount", "must be positive")
+	}
+
+	converter := exchange.NewConverter(currency, rateLookup(s.mongodb))
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		if err := checkCategory(sc, s.mongodb, req.UserId, req.FromCategoryId, validation.Expense, "from_category_id"); err != nil {
+			return err
+		}
+		available, err := s.envelopeAvailable(sc, req.UserId, req.FromCategoryId, month, converter)
+		if err != nil {
+			return err
+		}
+		if err := envelope.CheckMove(available, amount, currency); err != nil {
+			return err
+		}
+
+		if err := s.assignToEnvelope(sc, req.UserId, req.FromCategoryId, "from_category_id", month, currency, -amount); err != nil {
+			return err
+		}
+		return s.assignToEnvelope(sc, req.UserId, req.ToCategoryId, "to_category_id", month, currency, amount)
+	})
+	if err != nil {
+		s.logger.Error("Error while moving between envelopes", slog.Any("error", err))
+		return nil, err
+	}
+
+	return s.GetEnvelopeSummary(ctx, &pb.GetEnvelopeSummaryRequest{UserId: req.UserId, Month: req.Month})
+}
+
+func (s *BudgetStorage) GetEnvelopeSummary(ctx context.Context, req *pb.GetEnvelopeSummaryRequest) (*pb.EnvelopeSummaryResponse, error) {
+	s.logger.Info("GetEnvelopeSummary", slog.String("req", req.String()))
+
+	month, err := envelope.Month(req.Month, time.Now())
+	if err != nil {
+		s.logger.Error("Invalid envelope month", slog.Any("error", err))
+		return nil, err
+	}
+	monthEnd := month.AddDate(0, 1, -1)
+
+	currency, err := s.envelopeCurrency(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	converter := exchange.NewConverter(currency, rateLookup(s.mongodb))
+
+	assignments, err := s.envelopeAssignments(ctx, req.UserId, "", month, converter)
+	if err != nil {
+		return nil, err
+	}
+	summary := envelope.Summarize(assignments, month)
+
+	income, err := s.envelopeIncome(ctx, req.UserId, month, monthEnd, converter)
+	if err != nil {
+		return nil, err
+	}
+	incomeAll, err := s.envelopeIncome(ctx, req.UserId, summary.FirstMonth, monthEnd, converter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.EnvelopeSummaryResponse{
+		Month:        month.Format("2006-01"),
+		Currency:     currency,
+		Income:       money.ToProto(income, currency),
+		Assigned:     money.ToProto(summary.Assigned, currency),
+		ToBeBudgeted: money.ToProto(summary.ToBeBudgeted(incomeAll), currency),
+	}
+
+	for _, e := range summary.Envelopes {
+		spent, err := categorySpent(ctx, s.mongodb, req.UserId, []string{e.CategoryID}, month, monthEnd, converter)
+		if err != nil {
+			s.logger.Error("Error while summing envelope spending", slog.Any("error", err))
+			return nil, err
+		}
+		spentAll, err := categorySpent(ctx, s.mongodb, req.UserId, []string{e.CategoryID}, e.FirstMonth, monthEnd, converter)
+		if err != nil {
+			s.logger.Error("Error while summing envelope spending", slog.Any("error", err))
+			return nil, err
+		}
+		name, err := lookupCategoryName(ctx, s.mongodb, e.CategoryID)
+		if err != nil {
+			s.logger.Error("Error while querying category", slog.Any("error", err))
+			return nil, err
+		}
+
+		response.Envelopes = append(response.Envelopes, &pb.Envelope{
+			CategoryId:   e.CategoryID,
+			CategoryName: name,
+			Assigned:     money.ToProto(e.Assigned, currency),
+			Spent:        money.ToProto(spent, currency),
+			Available:    money.ToProto(e.Available(spentAll), currency),
+		})
+	}
+
+	return response, nil
+}
+
+// assignToEnvelope adds amount, which may be negative, to what the category's
+// envelope holds for the month, creating the envelope if needed. A category
+// that cannot take an envelope is reported against field.
+func (s *BudgetStorage) assignToEnvelope(sc mongo.SessionContext, userID, categoryID, field string, month time.Time, currency string, amount int64) error {
+	if err := checkCategory(sc, s.mongodb, userID, categoryID, validation.Expense, field); err != nil {
+		return err
+	}
+
+	budgetCollection := s.mongodb.Collection("budgets")
+	updated_at := time.Now()
+
+	filter := bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "category_id", Value: categoryID},
+		{Key: "envelope", Value: true},
+		{Key: "start_date", Value: month},
+	}
+
+	var existing struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		Currency  string             `bson:"currency"`
+		DeletedAt *time.Time         `bson:"deleted_at"`
+	}
+	err := budgetCollection.FindOne(sc, filter).Decode(&existing)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		_, err = budgetCollection.InsertOne(sc, bson.D{
+			{Key: "user_id", Value: userID},
+			{Key: "category_id", Value: categoryID},
+			{Key: "amount", Value: amount},
+			{Key: "currency", Value: currency},
+			{Key: "period", Value: budgetperiod.Monthly},
+			{Key: "start_date", Value: month},
+			{Key: "end_date", Value: month.AddDate(0, 1, -1)},
+			{Key: "envelope", Value: true},
+			{Key: "rollover", Value: budgetperiod.RolloverNone},
+			{Key: "carry_over", Value: int64(0)},
+			{Key: "alert_thresholds", Value: bson.A{}},
+			{Key: "alert_over_budget", Value: false},
+			{Key: "created_at", Value: updated_at},
+			{Key: "updated_at", Value: updated_at},
+			{Key: "deleted_at", Value: nil},
+		})
+		return err
+	case err != nil:
+		return err
+	}
+
+	if existing.DeletedAt != nil {
+		_, err = budgetCollection.UpdateOne(sc, bson.D{{Key: "_id", Value: existing.ID}}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "amount", Value: amount},
+			{Key: "currency", Value: currency},
+			{Key: "updated_at", Value: updated_at},
+			{Key: "deleted_at", Value: nil},
+		}}})
+		return err
+	}
+
+	if existing.Currency != currency {
+		return status.Errorf(codes.FailedPrecondition, "envelope for %s is kept in %s, not %s", month.Format("2006-01"), existing.Currency, currency)
+	}
+
+	_, err = budgetCollection.UpdateOne(sc, bson.D{{Key: "_id", Value: existing.ID}}, bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "amount", Value: amount}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: updated_at}}},
+	})
+	return err
+}
+
+// envelopeCurrency is the user's base currency, which envelopes are kept in.
+func (s *BudgetStorage) envelopeCurrency(ctx context.Context, userID string) (string, error) {
+	currency, err := baseCurrency(ctx, s.mongodb, s.cfg, userID)
+	if err != nil {
+		s.logger.Error("Error while querying base currency", slog.Any("error", err))
+		return "", err
+	}
+	return currency, nil
+}
+
+func (s *BudgetStorage) envelopeIncome(ctx context.Context, userID string, start, end time.Time, converter *exchange.Converter) (int64, error) {
+	income, err := sumTransactions(ctx, s.mongodb, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "type", Value: "income"},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lte", Value: end}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, nil, converter)
+	if err != nil {
+		s.logger.Error("Error while summing income", slog.Any("error", err))
+		return 0, err
+	}
+	return income, nil
+}
+
+// envelopeAssignments loads what the user has assigned to envelopes up to
+// and including month, in month order and in the converter's currency. An
+// empty categoryID loads every envelope.
+func (s *BudgetStorage) envelopeAssignments(ctx context.Context, userID, categoryID string, month time.Time, converter *exchange.Converter) ([]envelope.Assignment, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "envelope", Value: true},
+		{Key: "start_date", Value: bson.D{{Key: "$lte", Value: month}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if categoryID != "" {
+		filter = append(filter, bson.E{Key: "category_id", Value: categoryID})
+	}
+	cursor, err := s.mongodb.Collection("budgets").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "start_date", Value: 1}}))
+	if err != nil {
+		s.logger.Error("Error while retrieving envelopes", slog.Any("error", err))
+		return nil, err
+	}
+
+	var stored []struct {
+		CategoryID string    `bson:"category_id"`
+		Amount     int64     `bson:"amount"`
+		Currency   string    `bson:"currency"`
+		StartDate  time.Time `bson:"start_date"`
+	}
+	if err := cursor.All(ctx, &stored); err != nil {
+		s.logger.Error("Error while decoding envelope", slog.Any("error", err))
+		return nil, err
+	}
+
+	assignments := make([]envelope.Assignment, 0, len(stored))
+	for _, doc := range stored {
+		amount, err := converter.Convert(ctx, doc.Amount, doc.Currency, doc.StartDate)
+		if err != nil {
+			s.logger.Error("Error while converting envelope amount", slog.Any("error", err))
+			return nil, err
+		}
+		assignments = append(assignments, envelope.Assignment{CategoryID: doc.CategoryID, Month: doc.StartDate, Amount: amount})
+	}
+
+	return assignments, nil
+}
+
+// envelopeAvailable is what the category's envelope holds at the end of month.
+func (s *BudgetStorage) envelopeAvailable(ctx context.Context, userID, categoryID string, month time.Time, converter *exchange.Converter) (int64, error) {
+	assignments, err := s.envelopeAssignments(ctx, userID, categoryID, month, converter)
+	if err != nil {
+		return 0, err
+	}
+	summary := envelope.Summarize(assignments, month)
+	if len(summary.Envelopes) == 0 {
+		return 0, nil
+	}
+
+	e := summary.Envelopes[0]
+	spent, err := categorySpent(ctx, s.mongodb, userID, []string{categoryID}, e.FirstMonth, month.AddDate(0, 1, -1), converter)
+	if err != nil {
+		s.logger.Error("Error while summing envelope spending", slog.Any("error", err))
+		return 0, err
+	}
+	return e.Available(spent), nil
+}
@@ -0,0 +1,250 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/budget"
+	"budgeting-service/internal/items/budgetperiod"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// errBudgetRolled aborts a rollover that another scheduler has already made.
+var errBudgetRolled = errors.New("budget period already rolled over")
+
+type periodBudget struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	UserID       string             `bson:"user_id"`
+	CategoryID   string             `bson:"category_id"`
+	Amount       int64              `bson:"amount"`
+	Currency     string             `bson:"currency"`
+	Period       string             `bson:"period"`
+	StartDate    time.Time          `bson:"start_date"`
+	EndDate      time.Time          `bson:"end_date"`
+	PeriodAnchor time.Time          `bson:"period_anchor"`
+	Rollover     string             `bson:"rollover"`
+	CarryOver    int64              `bson:"carry_over"`
+}
+
+// RollOverBudgets moves every recurring budget whose period has ended into
+// its current period, closing each period it passes on the way as a row in
+// budget_periods. It returns the number of periods closed. Envelopes are left
+// alone; each month has an envelope of its own.
+func (s *BudgetStorage) RollOverBudgets(ctx context.Context, now time.Time) (int, error) {
+	periods := bson.A{budgetperiod.Weekly, budgetperiod.Biweekly, budgetperiod.Monthly, budgetperiod.Quarterly, budgetperiod.Yearly}
+
+	cursor, err := s.mongodb.Collection("budgets").Find(ctx, bson.D{
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "period", Value: bson.D{{Key: "$in", Value: periods}}},
+		{Key: "envelope", Value: bson.D{{Key: "$ne", Value: true}}},
+		{Key: "end_date", Value: bson.D{{Key: "$lt", Value: day(now)}}},
+	})
+	if err != nil {
+		s.logger.Error("Error while fetching ended budgets", slog.Any("error", err))
+		return 0, err
+	}
+
+	var budgets []periodBudget
+	if err := cursor.All(ctx, &budgets); err != nil {
+		s.logger.Error("Error while decoding budget", slog.Any("error", err))
+		return 0, err
+	}
+
+	closed := 0
+	for _, budget := range budgets {
+		if budget.PeriodAnchor.IsZero() {
+			budget.PeriodAnchor = budget.StartDate
+		}
+
+		for budget.EndDate.Before(day(now)) {
+			next, rolled, err := s.rollOverBudget(ctx, budget)
+			if err != nil {
+				s.logger.Error("Error rolling budget over", slog.String("id", budget.ID.Hex()), slog.Any("error", err))
+				break
+			}
+			if !rolled {
+				break
+			}
+			closed++
+			budget = next
+		}
+	}
+
+	return closed, nil
+}
+
+// rollOverBudget closes the budget's current period and starts the next one.
+// The history row and the move of the budget are written together, and the
+// move only happens while the budget still starts where it did when it was
+// read, so a period is never closed twice.
+func (s *BudgetStorage) rollOverBudget(ctx context.Context, budget periodBudget) (periodBudget, bool, error) {
+	nextStart, nextEnd, ok := budgetperiod.Next(budget.Period, budget.PeriodAnchor, budget.EndDate)
+	if !ok {
+		return budget, false, nil
+	}
+
+	categoryIDs, err := budgetCategories(ctx, s.mongodb, budget.CategoryID)
+	if err != nil {
+		return budget, false, err
+	}
+
+	converter := exchange.NewConverter(budget.Currency, rateLookup(s.mongodb))
+	spent, err := categorySpent(ctx, s.mongodb, budget.UserID, categoryIDs, budget.StartDate, budget.EndDate, converter)
+	if err != nil {
+		return budget, false, err
+	}
+
+	limit := budget.Amount + budget.CarryOver
+	carry := budgetperiod.Carry(budget.Rollover, limit, spent)
+	closed_at := time.Now()
+
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		_, err := s.mongodb.Collection("budget_periods").UpdateOne(sc, bson.D{
+			{Key: "budget_id", Value: budget.ID.Hex()},
+			{Key: "start_date", Value: budget.StartDate},
+		}, bson.D{{Key: "$setOnInsert", Value: bson.D{
+			{Key: "user_id", Value: budget.UserID},
+			{Key: "category_id", Value: budget.CategoryID},
+			{Key: "end_date", Value: budget.EndDate},
+			{Key: "amount", Value: budget.Amount},
+			{Key: "carry_over", Value: budget.CarryOver},
+			{Key: "spent", Value: spent},
+			{Key: "currency", Value: budget.Currency},
+			{Key: "closed_at", Value: closed_at},
+		}}}, options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+
+		res, err := s.mongodb.Collection("budgets").UpdateOne(sc, bson.D{
+			{Key: "_id", Value: budget.ID},
+			{Key: "start_date", Value: budget.StartDate},
+		}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "start_date", Value: nextStart},
+			{Key: "end_date", Value: nextEnd},
+			{Key: "period_anchor", Value: budget.PeriodAnchor},
+			{Key: "carry_over", Value: carry},
+			{Key: "alerts_fired", Value: bson.A{}},
+			{Key: "updated_at", Value: closed_at},
+		}}})
+		if err != nil {
+			return err
+		}
+		if res.ModifiedCount == 0 {
+			return errBudgetRolled
+		}
+		return nil
+	})
+	if errors.Is(err, errBudgetRolled) {
+		return budget, false, nil
+	}
+	if err != nil {
+		return budget, false, err
+	}
+
+	s.logger.Info("Budget rolled over",
+		slog.String("id", budget.ID.Hex()),
+		slog.Time("start_date", nextStart),
+		slog.Int64("carry_over", carry),
+	)
+
+	budget.StartDate, budget.EndDate, budget.CarryOver = nextStart, nextEnd, carry
+	return budget, true, nil
+}
+
+func (s *BudgetStorage) GetBudgetPeriods(ctx context.Context, req *pb.GetBudgetPeriodsRequest) (*pb.BudgetPeriodsResponse, error) {
+	s.logger.Info("GetBudgetPeriods", slog.String("req", req.String()))
+
+	filter := bson.D{{Key: "budget_id", Value: req.BudgetId}}
+
+	page, err := newPage("", "", map[string]string{
+		"start_date": "start_date",
+	}, "start_date", req.PageSize, req.PageToken)
+	if err != nil {
+		s.logger.Error("Invalid page request", slog.Any("error", err))
+		return nil, err
+	}
+
+	cursor, err := s.mongodb.Collection("budget_periods").Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error("Error while retrieving budget periods", slog.Any("error", err))
+		return nil, err
+	}
+
+	var docs []bson.M
+	if err = cursor.All(ctx, &docs); err != nil {
+		s.logger.Error("Error while decoding budget period", slog.Any("error", err))
+		return nil, err
+	}
+
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error("Error while building page token", slog.Any("error", err))
+		return nil, err
+	}
+
+	var periods []*pb.BudgetPeriod
+	for _, period := range docs {
+		currency := storedString(period["currency"])
+		amount := storedAmount(period["amount"])
+		carryOver := storedAmount(period["carry_over"])
+		spent := storedAmount(period["spent"])
+
+		periods = append(periods, &pb.BudgetPeriod{
+			Id:        period["_id"].(primitive.ObjectID).Hex(),
+			BudgetId:  period["budget_id"].(string),
+			StartDate: period["start_date"].(primitive.DateTime).Time().UTC().Format("2006-01-02"),
+			EndDate:   period["end_date"].(primitive.DateTime).Time().UTC().Format("2006-01-02"),
+			Amount:    money.ToProto(amount, currency),
+			CarryOver: money.ToProto(carryOver, currency),
+			Spent:     money.ToProto(spent, currency),
+			Remaining: money.ToProto(amount+carryOver-spent, currency),
+			ClosedAt:  period["closed_at"].(primitive.DateTime).Time().String(),
+		})
+	}
+
+	return &pb.BudgetPeriodsResponse{Periods: periods, NextPageToken: nextPageToken}, nil
+}
+
+// EnsureIndexes keeps one history row per budget and period start, so a
+// rollover that is retried cannot record the same period twice, and one
+// envelope per user, category and month.
+func (s *BudgetStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("budget_periods").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "budget_id", Value: 1},
+			{Key: "start_date", Value: 1},
+		},
+		Options: options.Index().SetName("budget_period_start").SetUnique(true),
+	})
+	if err != nil {
+		s.logger.Error("Error creating budget period index", slog.Any("error", err))
+		return err
+	}
+
+	_, err = s.mongodb.Collection("budgets").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "category_id", Value: 1},
+			{Key: "start_date", Value: 1},
+		},
+		Options: options.Index().
+			SetName("envelope_month").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "envelope", Value: true}}),
+	})
+	if err != nil {
+		s.logger.Error("Error creating envelope index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
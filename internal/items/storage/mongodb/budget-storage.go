@@ -2,8 +2,12 @@ package mongodb
 
 import (
 	pb "budgeting-service/genproto/budget"
+	"budgeting-service/internal/items/budgetalert"
+	"budgeting-service/internal/items/budgetperiod"
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/money"
 	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/validation"
 	"context"
 	"time"
 
@@ -37,22 +41,68 @@ func (s *BudgetStorage) CreateBudget(ctx context.Context, req *pb.CreateBudgetRe
 	startDate, err := time.Parse("2006-01-02", req.StartDate)
 	if err != nil {
 		s.logger.Error("Error parsing start date", slog.Any("error", err))
-		return nil, err
+		return nil, validation.Field("start_date", "%q is not a date in the form YYYY-MM-DD", req.StartDate)
 	}
 
 	endDate, err := time.Parse("2006-01-02", req.EndDate)
 	if err != nil {
 		s.logger.Error("Error parsing end date", slog.Any("error", err))
+		return nil, validation.Field("end_date", "%q is not a date in the form YYYY-MM-DD", req.EndDate)
+	}
+	if endDate.Before(startDate) {
+		return nil, validation.Field("end_date", "must not be before start_date")
+	}
+
+	amount, err := money.FromProto(req.Amount)
+	if err != nil {
+		s.logger.Error("Invalid budget amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+
+	alerts := &pb.BudgetAlerts{Thresholds: budgetalert.DefaultThresholds, OverBudget: true}
+	if req.Alerts != nil {
+		thresholds, err := budgetalert.Thresholds(req.Alerts.Thresholds)
+		if err != nil {
+			s.logger.Error("Invalid budget alerts", slog.Any("error", err))
+			return nil, validation.Field("alerts.thresholds", "%v", err)
+		}
+		alerts = &pb.BudgetAlerts{Thresholds: thresholds, OverBudget: req.Alerts.OverBudget}
+	}
+
+	rollover, err := budgetperiod.Rollover(req.Rollover, req.Period)
+	if err != nil {
+		s.logger.Error("Invalid budget rollover", slog.Any("error", err))
+		return nil, validation.Field("rollover", "%v", err)
+	}
+
+	if err := checkCategory(ctx, s.mongodb, req.UserId, req.CategoryId, validation.Expense, "category_id"); err != nil {
+		s.logger.Error("Invalid budget category", slog.Any("error", err))
 		return nil, err
 	}
 
+	// A budget without a currency could not be converted against the
+	// transactions it tracks, so it defaults to the user's base currency.
+	currency := req.Amount.GetCurrencyCode()
+	if currency == "" {
+		if currency, err = baseCurrency(ctx, s.mongodb, s.cfg, req.UserId); err != nil {
+			s.logger.Error("Error while loading base currency", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
 	budgetDoc := bson.D{
 		{Key: "user_id", Value: req.UserId},
 		{Key: "category_id", Value: req.CategoryId},
-		{Key: "amount", Value: req.Amount},
+		{Key: "amount", Value: amount},
+		{Key: "currency", Value: currency},
 		{Key: "period", Value: req.Period},
 		{Key: "start_date", Value: startDate},
 		{Key: "end_date", Value: endDate},
+		{Key: "period_anchor", Value: startDate},
+		{Key: "rollover", Value: rollover},
+		{Key: "carry_over", Value: int64(0)},
+		{Key: "alert_thresholds", Value: alerts.Thresholds},
+		{Key: "alert_over_budget", Value: alerts.OverBudget},
 		{Key: "created_at", Value: created_at},
 		{Key: "updated_at", Value: created_at},
 		{Key: "deleted_at", Value: nil},
@@ -70,11 +120,14 @@ func (s *BudgetStorage) CreateBudget(ctx context.Context, req *pb.CreateBudgetRe
 		Id:         budgetID.Hex(),
 		UserId:     req.UserId,
 		CategoryId: req.CategoryId,
-		Amount:     req.Amount,
+		Amount:     money.ToProto(amount, currency),
 		Period:     req.Period,
 		StartDate:  req.StartDate,
 		EndDate:    req.EndDate,
 		CreatedAt:  created_at.String(),
+		Alerts:     alerts,
+		Rollover:   rollover,
+		CarryOver:  money.ToProto(0, currency),
 	}, nil
 }
 
@@ -82,42 +135,51 @@ func (s *BudgetStorage) GetBudgets(ctx context.Context, req *pb.GetBudgetsReques
 	s.logger.Info("GetBudgets", slog.String("req", req.String()))
 	budgetCollection := s.mongodb.Collection("budgets")
 
-	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if req.CategoryId != "" {
+		filter = append(filter, bson.E{Key: "category_id", Value: req.CategoryId})
+	}
+	if req.Period != "" {
+		filter = append(filter, bson.E{Key: "period", Value: req.Period})
+	}
 
-	cursor, err := budgetCollection.Find(ctx, filter)
+	page, err := newPage(req.SortBy, req.SortOrder, map[string]string{
+		"start_date": "start_date",
+		"amount":     "amount",
+		"created_at": "created_at",
+	}, "start_date", req.PageSize, req.PageToken)
+	if err != nil {
+		s.logger.Error("Invalid page request", slog.Any("error", err))
+		return nil, err
+	}
+
+	cursor, err := budgetCollection.Find(ctx, page.filter(filter), page.findOptions())
 	if err != nil {
 		s.logger.Error("Error while retrieving budgets", slog.Any("error", err))
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var budgets []*pb.BudgetResponse
-	for cursor.Next(ctx) {
-		var budget bson.M
-		if err = cursor.Decode(&budget); err != nil {
-			s.logger.Error("Error while decoding budget", slog.Any("error", err))
-			return nil, err
-		}
+	var docs []bson.M
+	if err = cursor.All(ctx, &docs); err != nil {
+		s.logger.Error("Error while decoding budget", slog.Any("error", err))
+		return nil, err
+	}
 
-		budgets = append(budgets, &pb.BudgetResponse{
-			Id:         budget["_id"].(primitive.ObjectID).Hex(),
-			UserId:     budget["user_id"].(string),
-			CategoryId: budget["category_id"].(string),
-			Amount:     float32(budget["amount"].(float64)),
-			Period:     budget["period"].(string),
-			StartDate:  budget["start_date"].(primitive.DateTime).Time().String(),
-			EndDate:    budget["end_date"].(primitive.DateTime).Time().String(),
-			CreatedAt:  budget["created_at"].(primitive.DateTime).Time().String(),
-			UpdatedAt:  budget["updated_at"].(primitive.DateTime).Time().String(),
-		})
-	}
-
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("Error while iterating over cursor", slog.Any("error", err))
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error("Error while building page token", slog.Any("error", err))
 		return nil, err
 	}
 
-	return &pb.BudgetsResponse{Budgets: budgets}, nil
+	var budgets []*pb.BudgetResponse
+	for _, budget := range docs {
+		budgets = append(budgets, budgetResponse(budget))
+	}
+
+	return &pb.BudgetsResponse{Budgets: budgets, NextPageToken: nextPageToken}, nil
 }
 
 func (s *BudgetStorage) GetBudgetById(ctx context.Context, req *pb.GetBudgetByIdRequest) (*pb.BudgetResponse, error) {
@@ -143,17 +205,7 @@ func (s *BudgetStorage) GetBudgetById(ctx context.Context, req *pb.GetBudgetById
 		return nil, err
 	}
 
-	return &pb.BudgetResponse{
-		Id:         budget["_id"].(primitive.ObjectID).Hex(),
-		UserId:     budget["user_id"].(string),
-		CategoryId: budget["category_id"].(string),
-		Amount:     float32(budget["amount"].(float64)),
-		Period:     budget["period"].(string),
-		StartDate:  budget["start_date"].(primitive.DateTime).Time().String(),
-		EndDate:    budget["end_date"].(primitive.DateTime).Time().String(),
-		CreatedAt:  budget["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt:  budget["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return budgetResponse(budget), nil
 }
 
 func (s *BudgetStorage) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.BudgetResponse, error) {
@@ -171,27 +223,115 @@ func (s *BudgetStorage) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRe
 	}
 
 	updateFields := bson.D{}
-	if req.Amount != 0 {
-		updateFields = append(updateFields, bson.E{Key: "amount", Value: req.Amount})
+	if req.Amount != nil {
+		amount, err := money.FromProto(req.Amount)
+		if err != nil {
+			s.logger.Error("Invalid budget amount", slog.Any("error", err))
+			return nil, validation.Field("amount", "%v", err)
+		}
+		updateFields = append(updateFields, bson.E{Key: "amount", Value: amount})
+		currency := req.Amount.CurrencyCode
+		if currency == "" {
+			// The amount stays in the budget's currency; one stored before
+			// budgets defaulted to the base currency gets it now.
+			var stored struct {
+				UserID   string `bson:"user_id"`
+				Currency string `bson:"currency"`
+			}
+			if err := budgetCollection.FindOne(ctx, filter).Decode(&stored); err != nil && err != mongo.ErrNoDocuments {
+				s.logger.Error("Error while retrieving budget", slog.Any("error", err))
+				return nil, err
+			}
+			if stored.Currency == "" && stored.UserID != "" {
+				if currency, err = baseCurrency(ctx, s.mongodb, s.cfg, stored.UserID); err != nil {
+					s.logger.Error("Error while loading base currency", slog.Any("error", err))
+					return nil, err
+				}
+			}
+		}
+		if currency != "" {
+			updateFields = append(updateFields, bson.E{Key: "currency", Value: currency})
+		}
 	}
 	if req.Period != "" {
 		updateFields = append(updateFields, bson.E{Key: "period", Value: req.Period})
 	}
-	if req.StartDate != "" {
-		startDate, err := time.Parse("2006-01-02", req.StartDate)
-		if err != nil {
-			s.logger.Error("Error parsing start date", slog.Any("error", err))
+	if req.StartDate != "" || req.EndDate != "" {
+		var stored struct {
+			StartDate time.Time `bson:"start_date"`
+			EndDate   time.Time `bson:"end_date"`
+		}
+		if err := budgetCollection.FindOne(ctx, filter).Decode(&stored); err != nil && err != mongo.ErrNoDocuments {
+			s.logger.Error("Error while retrieving budget", slog.Any("error", err))
 			return nil, err
 		}
-		updateFields = append(updateFields, bson.E{Key: "start_date", Value: startDate})
+		startDate, endDate := stored.StartDate, stored.EndDate
+
+		if req.StartDate != "" {
+			startDate, err = time.Parse("2006-01-02", req.StartDate)
+			if err != nil {
+				s.logger.Error("Error parsing start date", slog.Any("error", err))
+				return nil, validation.Field("start_date", "%q is not a date in the form YYYY-MM-DD", req.StartDate)
+			}
+			updateFields = append(updateFields,
+				bson.E{Key: "start_date", Value: startDate},
+				bson.E{Key: "period_anchor", Value: startDate},
+			)
+		}
+		if req.EndDate != "" {
+			endDate, err = time.Parse("2006-01-02", req.EndDate)
+			if err != nil {
+				s.logger.Error("Error parsing end date", slog.Any("error", err))
+				return nil, validation.Field("end_date", "%q is not a date in the form YYYY-MM-DD", req.EndDate)
+			}
+			updateFields = append(updateFields, bson.E{Key: "end_date", Value: endDate})
+		}
+		if !startDate.IsZero() && !endDate.IsZero() && endDate.Before(startDate) {
+			return nil, validation.Field("end_date", "must not be before start_date")
+		}
 	}
-	if req.EndDate != "" {
-		endDate, err := time.Parse("2006-01-02", req.StartDate)
+	// The rollover mode has to suit the period, so changing either checks the
+	// pair, with the stored value standing in for the one left alone.
+	if req.Rollover != "" || req.Period != "" {
+		mode, period := req.Rollover, req.Period
+		if mode == "" || period == "" {
+			var stored struct {
+				Period   string `bson:"period"`
+				Rollover string `bson:"rollover"`
+			}
+			if err := budgetCollection.FindOne(ctx, filter).Decode(&stored); err != nil && err != mongo.ErrNoDocuments {
+				s.logger.Error("Error while retrieving budget", slog.Any("error", err))
+				return nil, err
+			}
+			if mode == "" {
+				mode = stored.Rollover
+			}
+			if period == "" {
+				period = stored.Period
+			}
+		}
+		rollover, err := budgetperiod.Rollover(mode, period)
 		if err != nil {
-			s.logger.Error("Error parsing start date", slog.Any("error", err))
-			return nil, err
+			s.logger.Error("Invalid budget rollover", slog.Any("error", err))
+			if req.Rollover == "" {
+				return nil, validation.Field("period", "%v; set rollover to %s to use it", err, budgetperiod.RolloverNone)
+			}
+			return nil, validation.Field("rollover", "%v", err)
+		}
+		if req.Rollover != "" {
+			updateFields = append(updateFields, bson.E{Key: "rollover", Value: rollover})
 		}
-		updateFields = append(updateFields, bson.E{Key: "end_date", Value: endDate})
+	}
+	if req.Alerts != nil {
+		thresholds, err := budgetalert.Thresholds(req.Alerts.Thresholds)
+		if err != nil {
+			s.logger.Error("Invalid budget alerts", slog.Any("error", err))
+			return nil, validation.Field("alerts.thresholds", "%v", err)
+		}
+		updateFields = append(updateFields,
+			bson.E{Key: "alert_thresholds", Value: thresholds},
+			bson.E{Key: "alert_over_budget", Value: req.Alerts.OverBudget},
+		)
 	}
 	if len(updateFields) > 0 {
 		updateFields = append(updateFields, bson.E{Key: "updated_at", Value: time.Now()})
@@ -220,17 +360,7 @@ func (s *BudgetStorage) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRe
 		return nil, err
 	}
 
-	return &pb.BudgetResponse{
-		Id:         updatedBudget["_id"].(primitive.ObjectID).Hex(),
-		UserId:     updatedBudget["user_id"].(string),
-		CategoryId: updatedBudget["category_id"].(string),
-		Amount:     float32(updatedBudget["amount"].(float64)),
-		Period:     updatedBudget["period"].(string),
-		StartDate:  updatedBudget["start_date"].(primitive.DateTime).Time().String(),
-		EndDate:    updatedBudget["end_date"].(primitive.DateTime).Time().String(),
-		CreatedAt:  updatedBudget["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt:  updatedBudget["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return budgetResponse(updatedBudget), nil
 }
 
 func (s *BudgetStorage) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.Empty, error) {
@@ -257,3 +387,46 @@ func (s *BudgetStorage) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRe
 
 	return &pb.Empty{}, nil
 }
+
+func budgetResponse(budget bson.M) *pb.BudgetResponse {
+	currency := storedString(budget["currency"])
+	envelope, _ := budget["envelope"].(bool)
+	rollover := storedString(budget["rollover"])
+	if rollover == "" {
+		rollover = budgetperiod.RolloverNone
+	}
+
+	return &pb.BudgetResponse{
+		Id:         budget["_id"].(primitive.ObjectID).Hex(),
+		UserId:     budget["user_id"].(string),
+		CategoryId: budget["category_id"].(string),
+		Amount:     money.ToProto(storedAmount(budget["amount"]), currency),
+		Period:     budget["period"].(string),
+		StartDate:  budget["start_date"].(primitive.DateTime).Time().String(),
+		EndDate:    budget["end_date"].(primitive.DateTime).Time().String(),
+		CreatedAt:  budget["created_at"].(primitive.DateTime).Time().String(),
+		UpdatedAt:  budget["updated_at"].(primitive.DateTime).Time().String(),
+		Alerts:     budgetAlerts(budget),
+		Rollover:   rollover,
+		CarryOver:  money.ToProto(storedAmount(budget["carry_over"]), currency),
+		Envelope:   envelope,
+	}
+}
+
+// budgetAlerts reads the alert settings of a stored budget. Budgets saved
+// before alerts existed get the defaults.
+func budgetAlerts(budget bson.M) *pb.BudgetAlerts {
+	stored, ok := budget["alert_thresholds"].(bson.A)
+	if !ok {
+		return &pb.BudgetAlerts{Thresholds: budgetalert.DefaultThresholds, OverBudget: true}
+	}
+
+	alerts := &pb.BudgetAlerts{}
+	for _, threshold := range stored {
+		if percent, ok := threshold.(int32); ok {
+			alerts.Thresholds = append(alerts.Thresholds, percent)
+		}
+	}
+	alerts.OverBudget, _ = budget["alert_over_budget"].(bool)
+	return alerts
+}
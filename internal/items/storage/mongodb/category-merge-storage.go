@@ -0,0 +1,374 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/category"
+	"budgeting-service/internal/items/categorytree"
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errCategoryInUse    = status.Error(codes.FailedPrecondition, "the category is still in use; give reassign_to to move what is booked to it")
+	errCategoryArchived = validation.Field("id", "the category is already archived")
+)
+
+// MergeCategories moves everything booked to the source category, and its
+// subcategories, to the target and deletes the source. It returns the target.
+func (s *CategoryStorage) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("MergeCategories", slog.String("req", req.String()))
+
+	source, err := s.liveCategory(ctx, req.SourceId)
+	if err != nil || source == nil {
+		return nil, err
+	}
+
+	if err := s.checkReassignTarget(ctx, source, req.TargetId, "target_id"); err != nil {
+		s.logger.Error("Invalid merge", slog.Any("error", err))
+		return nil, err
+	}
+
+	checkMerge := func(tree *categorytree.Tree) error {
+		if err := tree.CheckMerge(req.SourceId, req.TargetId); err != nil {
+			return validation.Field("target_id", "%v", err)
+		}
+		return nil
+	}
+
+	_, err = s.retireCategory(ctx, source, req.TargetId, req.TargetId, checkMerge, bson.D{
+		{Key: "deleted_at", Value: time.Now()},
+		{Key: "merged_into", Value: req.TargetId},
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Error while merging categories", slog.Any("error", err))
+		return nil, err
+	}
+
+	target, err := s.liveCategory(ctx, req.TargetId)
+	if err != nil || target == nil {
+		return nil, err
+	}
+	return categoryResponse(target), nil
+}
+
+// ArchiveCategory takes a category out of use while keeping it: it is no
+// longer listed, unless archived categories are asked for, and nothing can be
+// put under it. Like a deleted category, one still in use has to be given a
+// category to reassign everything to.
+func (s *CategoryStorage) ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("ArchiveCategory", slog.String("req", req.String()))
+
+	category, err := s.liveCategory(ctx, req.Id)
+	if err != nil || category == nil {
+		return nil, err
+	}
+	if category["archived_at"] != nil {
+		return nil, errCategoryArchived
+	}
+
+	if req.ReassignTo != "" {
+		if err := s.checkReassignTarget(ctx, category, req.ReassignTo, "reassign_to"); err != nil {
+			s.logger.Error("Invalid reassignment", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	archived, err := s.retireCategory(ctx, category, req.ReassignTo, storedString(category["parent_id"]), nil, bson.D{
+		{Key: "archived_at", Value: time.Now()},
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Error while archiving category", slog.Any("error", err))
+		return nil, err
+	}
+
+	return categoryResponse(archived), nil
+}
+
+// retireCategory takes a category out of use in one transaction: everything
+// booked to it is reassigned to reassignTo, its subcategories are put under
+// childrenTo, and retire is set on it. Without reassignTo it fails with
+// errCategoryInUse if anything live is still booked to the category. The
+// category is only retired if it has not been archived or unarchived since
+// it was loaded, so it is archived only once. Like a move, it bumps the
+// user's tree version, and checkTree, if given, vets the tree as the
+// transaction sees it.
+func (s *CategoryStorage) retireCategory(ctx context.Context, category bson.M, reassignTo, childrenTo string, checkTree func(*categorytree.Tree) error, retire bson.D) (bson.M, error) {
+	categoryCollection := s.mongodb.Collection("categories")
+	objID := category["_id"].(primitive.ObjectID)
+	categoryID := objID.Hex()
+	userID := storedString(category["user_id"])
+
+	var retired bson.M
+	err := withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		if err := bumpCategoryTree(sc, s.mongodb, userID); err != nil {
+			return err
+		}
+		if checkTree != nil {
+			tree, err := userCategoryTree(sc, s.mongodb, userID)
+			if err != nil {
+				return err
+			}
+			if err := checkTree(tree); err != nil {
+				return err
+			}
+		}
+
+		if reassignTo == "" {
+			inUse, err := categoryInUse(sc, s.mongodb, userID, categoryID)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				return errCategoryInUse
+			}
+		} else if err := reassignCategory(sc, s.mongodb, userID, categoryID, reassignTo); err != nil {
+			return err
+		}
+
+		updated_at := time.Now()
+		_, err := categoryCollection.UpdateMany(sc, bson.D{
+			{Key: "parent_id", Value: categoryID},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "parent_id", Value: childrenTo},
+			{Key: "updated_at", Value: updated_at},
+		}}})
+		if err != nil {
+			return err
+		}
+
+		filter := bson.D{
+			{Key: "_id", Value: objID},
+			{Key: "archived_at", Value: category["archived_at"]},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		}
+		update := bson.D{{Key: "$set", Value: append(retire, bson.E{Key: "updated_at", Value: updated_at})}}
+		return categoryCollection.FindOneAndUpdate(sc, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&retired)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return retired, nil
+}
+
+// liveCategory loads a category that is not deleted, or returns nil.
+func (s *CategoryStorage) liveCategory(ctx context.Context, id string) (bson.M, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var category bson.M
+	err = s.mongodb.Collection("categories").FindOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}).Decode(&category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Info("Category not found", slog.String("id", id))
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// checkReassignTarget checks that what is booked to category can be moved to
+// targetID: another live, unarchived category of the same user and type.
+func (s *CategoryStorage) checkReassignTarget(ctx context.Context, category bson.M, targetID, field string) error {
+	if targetID == category["_id"].(primitive.ObjectID).Hex() {
+		return validation.Field(field, "a category cannot be reassigned to itself")
+	}
+	return checkCategory(ctx, s.mongodb, storedString(category["user_id"]), targetID, storedString(category["type"]), field)
+}
+
+// categoryInUse reports whether any live transaction, split, budget,
+// recurring transaction or rule of the user is booked to the category.
+func categoryInUse(ctx context.Context, db *mongo.Database, userID, categoryID string) (bool, error) {
+	live := bson.E{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}}
+	user := bson.E{Key: "user_id", Value: userID}
+
+	uses := []struct {
+		collection string
+		filter     bson.D
+	}{
+		{"transactions", bson.D{user, live, categoriesFilter([]string{categoryID})}},
+		{"budgets", bson.D{user, live, {Key: "category_id", Value: categoryID}}},
+		{"recurring_transactions", bson.D{user, live, {Key: "category_id", Value: categoryID}}},
+		{"rules", bson.D{user, live, {Key: "actions.category_id", Value: categoryID}}},
+	}
+	for _, use := range uses {
+		count, err := db.Collection(use.collection).CountDocuments(ctx, use.filter, options.Count().SetLimit(1))
+		if err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// reassignCategory moves everything of the user's booked to one category to
+// another: transactions and their splits, budgets and envelopes, recurring
+// transactions and the rules that categorize into it. Deleted transactions
+// and budgets move too. Deleted envelopes stay with the old category, as
+// mergeEnvelopes explains.
+func reassignCategory(sc mongo.SessionContext, db *mongo.Database, userID, fromID, toID string) error {
+	updated_at := time.Now()
+	user := bson.E{Key: "user_id", Value: userID}
+	moveTo := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "category_id", Value: toID},
+		{Key: "updated_at", Value: updated_at},
+	}}}
+
+	_, err := db.Collection("transactions").UpdateMany(sc, bson.D{user, {Key: "category_id", Value: fromID}}, moveTo)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection("transactions").UpdateMany(sc, bson.D{user, {Key: "splits.category_id", Value: fromID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "splits.$[split].category_id", Value: toID},
+			{Key: "updated_at", Value: updated_at},
+		}}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.D{{Key: "split.category_id", Value: fromID}},
+		}}))
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection("recurring_transactions").UpdateMany(sc, bson.D{user, {Key: "category_id", Value: fromID}}, moveTo)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection("rules").UpdateMany(sc, bson.D{user, {Key: "actions.category_id", Value: fromID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "actions.category_id", Value: toID},
+			{Key: "updated_at", Value: updated_at},
+		}}})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection("budgets").UpdateMany(sc, bson.D{
+		user,
+		{Key: "category_id", Value: fromID},
+		{Key: "envelope", Value: bson.D{{Key: "$ne", Value: true}}},
+	}, moveTo)
+	if err != nil {
+		return err
+	}
+
+	return mergeEnvelopes(sc, db, userID, fromID, toID)
+}
+
+// mergeEnvelopes moves the live envelopes of one category to another. There
+// is one envelope per category and month, deleted or not, so where the target
+// already has an envelope for the month the amounts are added together and
+// the source's envelope is deleted instead. Deleted envelopes hold nothing and
+// are left where they are, since the target may well have a month of its own
+// for them.
+func mergeEnvelopes(sc mongo.SessionContext, db *mongo.Database, userID, fromID, toID string) error {
+	budgetCollection := db.Collection("budgets")
+
+	cursor, err := budgetCollection.Find(sc, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "category_id", Value: fromID},
+		{Key: "envelope", Value: true},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	var envelopes []bson.M
+	if err := cursor.All(sc, &envelopes); err != nil {
+		return err
+	}
+
+	updated_at := time.Now()
+	for _, envelope := range envelopes {
+		var existing struct {
+			ID        primitive.ObjectID `bson:"_id"`
+			Currency  string             `bson:"currency"`
+			DeletedAt *time.Time         `bson:"deleted_at"`
+		}
+		err := budgetCollection.FindOne(sc, bson.D{
+			{Key: "user_id", Value: userID},
+			{Key: "category_id", Value: toID},
+			{Key: "envelope", Value: true},
+			{Key: "start_date", Value: envelope["start_date"]},
+		}).Decode(&existing)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			_, err = budgetCollection.UpdateOne(sc, bson.D{{Key: "_id", Value: envelope["_id"]}}, bson.D{{Key: "$set", Value: bson.D{
+				{Key: "category_id", Value: toID},
+				{Key: "updated_at", Value: updated_at},
+			}}})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		amount := storedAmount(envelope["amount"])
+		currency := storedString(envelope["currency"])
+		var update bson.D
+		switch {
+		case existing.DeletedAt != nil:
+			update = bson.D{{Key: "$set", Value: bson.D{
+				{Key: "amount", Value: amount},
+				{Key: "currency", Value: currency},
+				{Key: "updated_at", Value: updated_at},
+				{Key: "deleted_at", Value: nil},
+			}}}
+		case existing.Currency != currency:
+			month := envelope["start_date"].(primitive.DateTime).Time().UTC()
+			return status.Errorf(codes.FailedPrecondition, "envelopes for %s are kept in %s and %s", month.Format("2006-01"), existing.Currency, currency)
+		default:
+			update = bson.D{
+				{Key: "$inc", Value: bson.D{{Key: "amount", Value: amount}}},
+				{Key: "$set", Value: bson.D{{Key: "updated_at", Value: updated_at}}},
+			}
+		}
+		if _, err := budgetCollection.UpdateOne(sc, bson.D{{Key: "_id", Value: existing.ID}}, update); err != nil {
+			return err
+		}
+
+		_, err = budgetCollection.UpdateOne(sc, bson.D{{Key: "_id", Value: envelope["_id"]}}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "updated_at", Value: updated_at},
+			{Key: "deleted_at", Value: updated_at},
+		}}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	pb "budgeting-service/genproto/category"
+	"budgeting-service/internal/items/categoryseed"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// categorySeed records which version of the default categories a user has
+// been given, and in which locale. Version 0 means the user had categories of
+// their own on first use and was given none.
+type categorySeed struct {
+	UserID  string `bson:"user_id"`
+	Version int    `bson:"version"`
+	Locale  string `bson:"locale"`
+}
+
+// EnsureDefaultCategories gives a user the default categories, named in
+// locale, the first time they are looked for. A user who already has
+// categories of their own is left alone, though they can still ask for the
+// defaults.
+func (s *CategoryStorage) EnsureDefaultCategories(ctx context.Context, userID, locale string) error {
+	err := s.mongodb.Collection("category_seeds").FindOne(ctx, bson.D{{Key: "user_id", Value: userID}}).Err()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Error("Error while retrieving category seed", slog.Any("error", err))
+		return err
+	}
+
+	count, err := s.mongodb.Collection("categories").CountDocuments(ctx, bson.D{{Key: "user_id", Value: userID}}, options.Count().SetLimit(1))
+	if err != nil {
+		s.logger.Error("Error while counting categories", slog.Any("error", err))
+		return err
+	}
+
+	if locale == "" {
+		locale = s.cfg.Categories.DefaultLocale
+	}
+
+	version := 0
+	if count == 0 {
+		if _, err := s.seedCategories(ctx, userID, locale, s.templates.Categories); err != nil {
+			s.logger.Error("Error while seeding categories", slog.String("user_id", userID), slog.Any("error", err))
+			return err
+		}
+		version = s.templates.Version
+	}
+
+	return s.recordSeed(ctx, userID, version, locale)
+}
+
+func (s *CategoryStorage) SeedDefaultCategories(ctx context.Context, req *pb.SeedDefaultCategoriesRequest) (*pb.SeedDefaultCategoriesResponse, error) {
+	s.logger.Info("SeedDefaultCategories", slog.String("req", req.String()))
+
+	var seed categorySeed
+	err := s.mongodb.Collection("category_seeds").FindOne(ctx, bson.D{{Key: "user_id", Value: req.UserId}}).Decode(&seed)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Error("Error while retrieving category seed", slog.Any("error", err))
+		return nil, err
+	}
+
+	locale := req.Locale
+	if locale == "" {
+		locale = seed.Locale
+	}
+	if locale == "" {
+		locale = s.cfg.Categories.DefaultLocale
+	}
+
+	if seed.Locale != "" && seed.Locale != locale {
+		if err := s.localizeCategories(ctx, req.UserId, seed.Locale, locale, s.templates.Categories); err != nil {
+			s.logger.Error("Error while renaming categories", slog.String("user_id", req.UserId), slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	created, err := s.seedCategories(ctx, req.UserId, locale, s.templates.Categories)
+	if err != nil {
+		s.logger.Error("Error while seeding categories", slog.String("user_id", req.UserId), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := s.recordSeed(ctx, req.UserId, s.templates.Version, locale); err != nil {
+		return nil, err
+	}
+
+	return &pb.SeedDefaultCategoriesResponse{Version: int32(s.templates.Version), Created: int32(created)}, nil
+}
+
+// PushDefaultCategories brings every user who has the defaults up to the
+// current version of the templates, adding only the categories introduced
+// since the version they have.
+func (s *CategoryStorage) PushDefaultCategories(ctx context.Context, req *pb.PushDefaultCategoriesRequest) (*pb.PushDefaultCategoriesResponse, error) {
+	s.logger.Info("PushDefaultCategories", slog.Int("version", s.templates.Version))
+
+	cursor, err := s.mongodb.Collection("category_seeds").Find(ctx, bson.D{
+		{Key: "version", Value: bson.D{{Key: "$gt", Value: 0}, {Key: "$lt", Value: s.templates.Version}}},
+	})
+	if err != nil {
+		s.logger.Error("Error while retrieving category seeds", slog.Any("error", err))
+		return nil, err
+	}
+
+	var seeds []categorySeed
+	if err := cursor.All(ctx, &seeds); err != nil {
+		s.logger.Error("Error while decoding category seed", slog.Any("error", err))
+		return nil, err
+	}
+
+	response := &pb.PushDefaultCategoriesResponse{Version: int32(s.templates.Version)}
+	for _, seed := range seeds {
+		created, err := s.seedCategories(ctx, seed.UserID, seed.Locale, s.templates.Since(seed.Version))
+		if err != nil {
+			s.logger.Error("Error while pushing categories", slog.String("user_id", seed.UserID), slog.Any("error", err))
+			return nil, err
+		}
+		if err := s.recordSeed(ctx, seed.UserID, s.templates.Version, seed.Locale); err != nil {
+			return nil, err
+		}
+		response.Users++
+		response.Created += int32(created)
+	}
+
+	return response, nil
+}
+
+// EnsureIndexes keeps one category per template and user, and one seed record
+// per user.
+func (s *CategoryStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("categories").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "template_key", Value: 1},
+		},
+		Options: options.Index().
+			SetName("category_template").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "template_key", Value: bson.D{{Key: "$type", Value: "string"}}}}),
+	})
+	if err != nil {
+		s.logger.Error("Error creating category template index", slog.Any("error", err))
+		return err
+	}
+
+	_, err = s.mongodb.Collection("category_seeds").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("category_seed_user").SetUnique(true),
+	})
+	if err != nil {
+		s.logger.Error("Error creating category seed index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// seedCategories creates the user's categories for templates that are not
+// made yet and returns how many it created. A template is made once per user,
+// so one the user has since deleted is not brought back, and a live category
+// of the user's own with the template's name is taken over rather than
+// duplicated.
+func (s *CategoryStorage) seedCategories(ctx context.Context, userID, locale string, templates []categoryseed.Template) (int, error) {
+	categoryCollection := s.mongodb.Collection("categories")
+
+	cursor, err := categoryCollection.Find(ctx, bson.D{{Key: "user_id", Value: userID}}, options.Find().SetProjection(bson.D{
+		{Key: "name", Value: 1},
+		{Key: "type", Value: 1},
+		{Key: "template_key", Value: 1},
+		{Key: "deleted_at", Value: 1},
+		{Key: "archived_at", Value: 1},
+	}))
+	if err != nil {
+		return 0, err
+	}
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, err
+	}
+
+	made := make(map[string]bson.M)
+	own := make(map[string]primitive.ObjectID)
+	for _, doc := range docs {
+		if key := storedString(doc["template_key"]); key != "" {
+			made[key] = doc
+		} else if doc["deleted_at"] == nil && doc["archived_at"] == nil {
+			own[storedString(doc["type"])+"/"+strings.ToLower(storedString(doc["name"]))] = doc["_id"].(primitive.ObjectID)
+		}
+	}
+
+	created := 0
+	for _, template := range templates {
+		if _, ok := made[template.Key]; ok {
+			continue
+		}
+		name := template.Name(locale)
+
+		if id, ok := own[template.Type+"/"+strings.ToLower(name)]; ok {
+			_, err := categoryCollection.UpdateOne(ctx, bson.D{
+				{Key: "_id", Value: id},
+				{Key: "template_key", Value: bson.D{{Key: "$exists", Value: false}}},
+			}, bson.D{{Key: "$set", Value: bson.D{{Key: "template_key", Value: template.Key}}}})
+			if err != nil && !mongo.IsDuplicateKeyError(err) {
+				return created, err
+			}
+		} else {
+			parentID := ""
+			if parent, ok := made[template.Parent]; ok && parent["deleted_at"] == nil && parent["archived_at"] == nil {
+				parentID = parent["_id"].(primitive.ObjectID).Hex()
+			}
+
+			now := time.Now()
+			res, err := categoryCollection.UpdateOne(ctx, bson.D{
+				{Key: "user_id", Value: userID},
+				{Key: "template_key", Value: template.Key},
+			}, bson.D{{Key: "$setOnInsert", Value: bson.D{
+				{Key: "name", Value: name},
+				{Key: "type", Value: template.Type},
+				{Key: "parent_id", Value: parentID},
+				{Key: "created_at", Value: now},
+				{Key: "updated_at", Value: now},
+				{Key: "deleted_at", Value: nil},
+			}}}, options.Update().SetUpsert(true))
+			if err != nil && !mongo.IsDuplicateKeyError(err) {
+				return created, err
+			}
+			if err == nil && res.UpsertedID != nil {
+				created++
+			}
+		}
+
+		// Whichever way it came about, possibly through a concurrent seed,
+		// the category for the template now exists.
+		var doc bson.M
+		err := categoryCollection.FindOne(ctx, bson.D{
+			{Key: "user_id", Value: userID},
+			{Key: "template_key", Value: template.Key},
+		}).Decode(&doc)
+		if err != nil {
+			return created, err
+		}
+		made[template.Key] = doc
+	}
+
+	return created, nil
+}
+
+// localizeCategories renames the user's default categories from their names
+// in one locale to those in another. A category the user has renamed keeps
+// the name they gave it.
+func (s *CategoryStorage) localizeCategories(ctx context.Context, userID, from, to string, templates []categoryseed.Template) error {
+	now := time.Now()
+	for _, template := range templates {
+		name := template.Name(to)
+		if name == template.Name(from) {
+			continue
+		}
+
+		_, err := s.mongodb.Collection("categories").UpdateOne(ctx, bson.D{
+			{Key: "user_id", Value: userID},
+			{Key: "template_key", Value: template.Key},
+			{Key: "name", Value: template.Name(from)},
+		}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "name", Value: name},
+			{Key: "updated_at", Value: now},
+		}}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CategoryStorage) recordSeed(ctx context.Context, userID string, version int, locale string) error {
+	now := time.Now()
+	update := bson.D{
+		{Key: "$max", Value: bson.D{{Key: "version", Value: version}}},
+		{Key: "$set", Value: bson.D{
+			{Key: "locale", Value: locale},
+			{Key: "updated_at", Value: now},
+		}},
+		{Key: "$setOnInsert", Value: bson.D{{Key: "created_at", Value: now}}},
+	}
+
+	filter := bson.D{{Key: "user_id", Value: userID}}
+	_, err := s.mongodb.Collection("category_seeds").UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// A concurrent first use inserted the record; update it instead.
+		_, err = s.mongodb.Collection("category_seeds").UpdateOne(ctx, filter, update)
+	}
+	if err != nil {
+		s.logger.Error("Error while recording category seed", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
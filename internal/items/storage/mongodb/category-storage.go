@@ -1,9 +1,15 @@
 package mongodb
 
 import (
+	"budgeting-service/internal/items/categoryseed"
+	"budgeting-service/internal/items/categorytree"
 	"budgeting-service/internal/items/config"
 	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/validation"
 	"context"
+	"errors"
+	"regexp"
+	"sort"
 	"time"
 
 	pb "budgeting-service/genproto/category"
@@ -17,16 +23,24 @@ import (
 )
 
 type CategoryStorage struct {
-	mongodb *mongo.Database
-	cfg     *config.Config
-	logger  *slog.Logger
+	mongodb   *mongo.Database
+	cfg       *config.Config
+	logger    *slog.Logger
+	templates *categoryseed.Set
 }
 
 func NewCategoryStorage(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) repository.CategoryI {
+	templates, err := categoryseed.Load(cfg.Categories.TemplatesFile)
+	if err != nil {
+		logger.Error("Error loading category templates, using the built-in ones", slog.Any("error", err))
+		templates = categoryseed.Default()
+	}
+
 	return &CategoryStorage{
-		mongodb: mongodb,
-		cfg:     cfg,
-		logger:  logger,
+		mongodb:   mongodb,
+		cfg:       cfg,
+		logger:    logger,
+		templates: templates,
 	}
 }
 
@@ -36,10 +50,21 @@ func (s *CategoryStorage) CreateCategory(ctx context.Context, req *pb.CreateCate
 	categoryCollection := s.mongodb.Collection("categories")
 	created_at := time.Now()
 
+	categoryType := req.Type
+	if req.ParentId != "" {
+		parent, err := s.categoryParent(ctx, req.UserId, req.ParentId, categoryType)
+		if err != nil {
+			s.logger.Error("Invalid parent category", slog.Any("error", err))
+			return nil, err
+		}
+		categoryType = storedString(parent["type"])
+	}
+
 	categoryDoc := bson.D{
 		{Key: "user_id", Value: req.UserId},
 		{Key: "name", Value: req.Name},
-		{Key: "type", Value: req.Type},
+		{Key: "type", Value: categoryType},
+		{Key: "parent_id", Value: req.ParentId},
 		{Key: "created_at", Value: created_at},
 		{Key: "updated_at", Value: created_at},
 		{Key: "deleted_at", Value: nil},
@@ -57,8 +82,9 @@ func (s *CategoryStorage) CreateCategory(ctx context.Context, req *pb.CreateCate
 		Id:        categoryID,
 		UserId:    req.UserId,
 		Name:      req.Name,
-		Type:      req.Type,
+		Type:      categoryType,
 		CreatedAt: created_at.String(),
+		ParentId:  req.ParentId,
 	}, nil
 }
 
@@ -66,39 +92,56 @@ func (s *CategoryStorage) GetCategories(ctx context.Context, req *pb.GetCategori
 	s.logger.Info("GetCategories", slog.String("req", req.String()))
 	categoryCollection := s.mongodb.Collection("categories")
 
-	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if req.Type != "" {
+		filter = append(filter, bson.E{Key: "type", Value: req.Type})
+	}
+	if !req.IncludeArchived {
+		filter = append(filter, bson.E{Key: "archived_at", Value: bson.D{{Key: "$eq", Value: nil}}})
+	}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(req.Search)},
+			{Key: "$options", Value: "i"},
+		}})
+	}
 
-	cursor, err := categoryCollection.Find(ctx, filter)
+	page, err := newPage(req.SortBy, req.SortOrder, map[string]string{
+		"name":       "name",
+		"created_at": "created_at",
+	}, "created_at", req.PageSize, req.PageToken)
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var categories []*pb.CategoryResponse
-	for cursor.Next(ctx) {
-		var category bson.M
-		if err := cursor.Decode(&category); err != nil {
-			s.logger.Error(err.Error())
-			return nil, err
-		}
+	cursor, err := categoryCollection.Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
 
-		categories = append(categories, &pb.CategoryResponse{
-			Id:        category["_id"].(primitive.ObjectID).Hex(),
-			UserId:    category["user_id"].(string),
-			Name:      category["name"].(string),
-			Type:      category["type"].(string),
-			CreatedAt: category["created_at"].(primitive.DateTime).Time().String(),
-			UpdatedAt: category["updated_at"].(primitive.DateTime).Time().String(),
-		})
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
-	return &pb.CategoriesResponse{Categories: categories}, nil
+	var categories []*pb.CategoryResponse
+	for _, category := range docs {
+		categories = append(categories, categoryResponse(category))
+	}
+
+	return &pb.CategoriesResponse{Categories: categories, NextPageToken: nextPageToken}, nil
 }
 
 func (s *CategoryStorage) GetCategoryById(ctx context.Context, req *pb.GetCategoryByIdRequest) (*pb.CategoryResponse, error) {
@@ -124,14 +167,7 @@ func (s *CategoryStorage) GetCategoryById(ctx context.Context, req *pb.GetCatego
 		return nil, err
 	}
 
-	return &pb.CategoryResponse{
-		Id:        category["_id"].(primitive.ObjectID).Hex(),
-		UserId:    category["user_id"].(string),
-		Name:      category["name"].(string),
-		Type:      category["type"].(string),
-		CreatedAt: category["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt: category["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return categoryResponse(category), nil
 }
 
 func (s *CategoryStorage) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
@@ -153,6 +189,10 @@ func (s *CategoryStorage) UpdateCategory(ctx context.Context, req *pb.UpdateCate
 		updateFields = append(updateFields, bson.E{Key: "name", Value: req.Name})
 	}
 	if req.Type != "" {
+		if err := s.checkTypeChange(ctx, objID, req.Type); err != nil {
+			s.logger.Error("Invalid category type", slog.Any("error", err))
+			return nil, err
+		}
 		updateFields = append(updateFields, bson.E{Key: "type", Value: req.Type})
 	}
 	if len(updateFields) > 0 {
@@ -182,17 +222,40 @@ func (s *CategoryStorage) UpdateCategory(ctx context.Context, req *pb.UpdateCate
 		return nil, err
 	}
 
-	return &pb.CategoryResponse{
-		Id:        updatedCategory["_id"].(primitive.ObjectID).Hex(),
-		UserId:    updatedCategory["user_id"].(string),
-		Name:      updatedCategory["name"].(string),
-		Type:      updatedCategory["type"].(string),
-		CreatedAt: updatedCategory["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt: updatedCategory["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return categoryResponse(updatedCategory), nil
 }
 
+// DeleteCategory refuses to delete a category that is still in use unless it
+// is given another category to reassign everything to. Subcategories of a
+// deleted category move up to take its place.
 func (s *CategoryStorage) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.Empty, error) {
+	s.logger.Info("DeleteCategory", slog.String("req", req.String()))
+
+	category, err := s.liveCategory(ctx, req.Id)
+	if err != nil || category == nil {
+		return &pb.Empty{}, err
+	}
+
+	if req.ReassignTo != "" {
+		if err := s.checkReassignTarget(ctx, category, req.ReassignTo, "reassign_to"); err != nil {
+			s.logger.Error("Invalid reassignment", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	_, err = s.retireCategory(ctx, category, req.ReassignTo, storedString(category["parent_id"]), nil, bson.D{
+		{Key: "deleted_at", Value: time.Now()},
+	})
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *CategoryStorage) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.CategoryResponse, error) {
+	s.logger.Info("MoveCategory", slog.String("req", req.String()))
 	categoryCollection := s.mongodb.Collection("categories")
 
 	objID, err := primitive.ObjectIDFromHex(req.Id)
@@ -201,18 +264,257 @@ func (s *CategoryStorage) DeleteCategory(ctx context.Context, req *pb.DeleteCate
 		return nil, err
 	}
 
-	filter := bson.D{{Key: "_id", Value: objID}}
-	update := bson.D{
-		{Key: "$set", Value: bson.D{
-			{Key: "deleted_at", Value: time.Now()},
-		}},
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+
+	var category bson.M
+	if err := categoryCollection.FindOne(ctx, filter).Decode(&category); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.Info("Category not found", slog.String("id", req.Id))
+			return nil, nil
+		}
+		s.logger.Error(err.Error())
+		return nil, err
 	}
+	userID := storedString(category["user_id"])
+
+	// The tree is checked and the category moved in one transaction that also
+	// bumps the user's tree version, so two moves racing each other conflict
+	// and the one retried checks the tree the other left behind.
+	var moved bson.M
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		if err := bumpCategoryTree(sc, s.mongodb, userID); err != nil {
+			return err
+		}
+
+		if req.ParentId != "" {
+			if _, err := s.categoryParent(sc, userID, req.ParentId, storedString(category["type"])); err != nil {
+				return err
+			}
+		}
 
-	_, err = categoryCollection.UpdateOne(ctx, filter, update)
+		tree, err := userCategoryTree(sc, s.mongodb, userID)
+		if err != nil {
+			return err
+		}
+		if err := tree.CheckMove(req.Id, req.ParentId); err != nil {
+			return validation.Field("parent_id", "%v", err)
+		}
+
+		update := bson.D{{Key: "$set", Value: bson.D{
+			{Key: "parent_id", Value: req.ParentId},
+			{Key: "updated_at", Value: time.Now()},
+		}}}
+		return categoryCollection.FindOneAndUpdate(sc, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&moved)
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Error while moving category", slog.Any("error", err))
+		return nil, err
+	}
+
+	return categoryResponse(moved), nil
+}
+
+func (s *CategoryStorage) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.CategoryTreeResponse, error) {
+	s.logger.Info("GetCategoryTree", slog.String("req", req.String()))
+
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "archived_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if req.Type != "" {
+		filter = append(filter, bson.E{Key: "type", Value: req.Type})
+	}
+
+	cursor, err := s.mongodb.Collection("categories").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
-	return &pb.Empty{}, nil
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	parents := make(map[string]string, len(docs))
+	categories := make(map[string]*pb.CategoryResponse, len(docs))
+	for _, doc := range docs {
+		category := categoryResponse(doc)
+		parents[category.Id] = category.ParentId
+		categories[category.Id] = category
+	}
+	tree := categorytree.New(parents)
+
+	var nodes func(parentID string) []*pb.CategoryNode
+	nodes = func(parentID string) []*pb.CategoryNode {
+		var children []*pb.CategoryNode
+		for _, id := range tree.Children(parentID) {
+			children = append(children, &pb.CategoryNode{Category: categories[id], Children: nodes(id)})
+		}
+		sort.SliceStable(children, func(i, j int) bool {
+			return children[i].Category.Name < children[j].Category.Name
+		})
+		return children
+	}
+
+	return &pb.CategoryTreeResponse{Roots: nodes("")}, nil
+}
+
+// categoryParent loads the category a category of categoryType is to be put
+// under. It has to be one of the user's live categories, of the same type.
+func (s *CategoryStorage) categoryParent(ctx context.Context, userID, parentID, categoryType string) (bson.M, error) {
+	objID, err := primitive.ObjectIDFromHex(parentID)
+	if err != nil {
+		return nil, validation.Field("parent_id", "%q is not a valid id", parentID)
+	}
+
+	var parent bson.M
+	err = s.mongodb.Collection("categories").FindOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "archived_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}).Decode(&parent)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, validation.Field("parent_id", "category %s not found", parentID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if categoryType != "" && storedString(parent["type"]) != categoryType {
+		return nil, validation.Field("parent_id", "a %s category cannot go under a %s category", categoryType, storedString(parent["type"]))
+	}
+	return parent, nil
+}
+
+// checkTypeChange refuses to change the type of a category that sits in a
+// tree, which keeps one type throughout, or that already has money booked to
+// it under its current type.
+func (s *CategoryStorage) checkTypeChange(ctx context.Context, id primitive.ObjectID, categoryType string) error {
+	category, err := s.liveCategory(ctx, id.Hex())
+	if err != nil || category == nil || storedString(category["type"]) == categoryType {
+		return err
+	}
+
+	if storedString(category["parent_id"]) != "" {
+		return validation.Field("type", "must match the parent category")
+	}
+
+	userID := storedString(category["user_id"])
+	children, err := s.mongodb.Collection("categories").CountDocuments(ctx, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "parent_id", Value: id.Hex()},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return validation.Field("type", "cannot change while the category has subcategories")
+	}
+
+	inUse, err := categoryInUse(ctx, s.mongodb, userID, id.Hex())
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return validation.Field("type", "cannot change while the category is in use")
+	}
+	return nil
+}
+
+// checkCategory checks that transactions or budgets of categoryType can be
+// booked to a category: it has to be one of the user's live, unarchived
+// categories and of that type. An empty categoryType accepts either.
+func checkCategory(ctx context.Context, db *mongo.Database, userID, categoryID, categoryType, field string) error {
+	objID, err := primitive.ObjectIDFromHex(categoryID)
+	if err != nil {
+		return validation.Field(field, "%q is not a valid id", categoryID)
+	}
+
+	var category struct {
+		Type       string              `bson:"type"`
+		ArchivedAt *primitive.DateTime `bson:"archived_at"`
+	}
+	err = db.Collection("categories").FindOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}).Decode(&category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return validation.Field(field, "category %s not found", categoryID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if category.ArchivedAt != nil {
+		return validation.Field(field, "category %s is archived", categoryID)
+	}
+	if categoryType != "" && category.Type != categoryType {
+		return validation.Field(field, "%s category %s cannot be used for %s", category.Type, categoryID, categoryType)
+	}
+	return nil
+}
+
+// bumpCategoryTree increments the version of the user's category tree. Every
+// transaction that checks the tree and then changes it bumps the version
+// first, so two of them running at once write the same document and conflict
+// instead of each acting on a tree the other is changing.
+func bumpCategoryTree(sc mongo.SessionContext, db *mongo.Database, userID string) error {
+	_, err := db.Collection("category_trees").UpdateOne(sc,
+		bson.D{{Key: "_id", Value: userID}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// userCategoryTree loads how the user's live categories nest.
+func userCategoryTree(ctx context.Context, db *mongo.Database, userID string) (*categorytree.Tree, error) {
+	cursor, err := db.Collection("categories").Find(ctx, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, options.Find().SetProjection(bson.D{{Key: "parent_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	parents := make(map[string]string, len(docs))
+	for _, doc := range docs {
+		parents[doc["_id"].(primitive.ObjectID).Hex()] = storedString(doc["parent_id"])
+	}
+	return categorytree.New(parents), nil
+}
+
+func categoryResponse(category bson.M) *pb.CategoryResponse {
+	response := &pb.CategoryResponse{
+		Id:          category["_id"].(primitive.ObjectID).Hex(),
+		UserId:      category["user_id"].(string),
+		Name:        category["name"].(string),
+		Type:        category["type"].(string),
+		CreatedAt:   category["created_at"].(primitive.DateTime).Time().String(),
+		UpdatedAt:   category["updated_at"].(primitive.DateTime).Time().String(),
+		ParentId:    storedString(category["parent_id"]),
+		TemplateKey: storedString(category["template_key"]),
+	}
+	if archivedAt, ok := category["archived_at"].(primitive.DateTime); ok {
+		response.ArchivedAt = archivedAt.Time().String()
+	}
+
+	return response
 }
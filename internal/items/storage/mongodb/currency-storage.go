@@ -0,0 +1,284 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/currency"
+	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CurrencyStorage struct {
+	mongodb *mongo.Database
+	cfg     *config.Config
+	logger  *slog.Logger
+}
+
+func NewCurrencyStorage(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) repository.CurrencyI {
+	return &CurrencyStorage{
+		mongodb: mongodb,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+func (s *CurrencyStorage) GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.UserSettings, error) {
+	s.logger.Info("GetUserSettings", slog.String("user_id", req.UserId))
+
+	var settings struct {
+		BaseCurrency string    `bson:"base_currency"`
+		UpdatedAt    time.Time `bson:"updated_at"`
+	}
+	err := s.mongodb.Collection("user_settings").FindOne(ctx, bson.D{{Key: "user_id", Value: req.UserId}}).Decode(&settings)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &pb.UserSettings{UserId: req.UserId, BaseCurrency: s.cfg.Currency.DefaultBase}, nil
+		}
+		s.logger.Error("Error while fetching user settings", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &pb.UserSettings{
+		UserId:       req.UserId,
+		BaseCurrency: settings.BaseCurrency,
+		UpdatedAt:    settings.UpdatedAt.String(),
+	}, nil
+}
+
+func (s *CurrencyStorage) UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UserSettings, error) {
+	s.logger.Info("UpdateUserSettings", slog.Any("req", req))
+
+	baseCurrency, err := exchange.Currency(req.BaseCurrency)
+	if err != nil {
+		s.logger.Error("Invalid base currency", slog.Any("error", err))
+		return nil, err
+	}
+
+	updated_at := time.Now()
+	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "base_currency", Value: baseCurrency},
+			{Key: "updated_at", Value: updated_at},
+		}},
+		{Key: "$setOnInsert", Value: bson.D{{Key: "created_at", Value: updated_at}}},
+	}
+
+	_, err = s.mongodb.Collection("user_settings").UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		s.logger.Error("Error while saving user settings", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &pb.UserSettings{
+		UserId:       req.UserId,
+		BaseCurrency: baseCurrency,
+		UpdatedAt:    updated_at.String(),
+	}, nil
+}
+
+func (s *CurrencyStorage) UpsertExchangeRates(ctx context.Context, req *pb.UpsertExchangeRatesRequest) (*pb.UpsertExchangeRatesResponse, error) {
+	s.logger.Info("UpsertExchangeRates", slog.Int("rates", len(req.Rates)))
+
+	rates := make([]exchange.Rate, 0, len(req.Rates))
+	for i, rate := range req.Rates {
+		date, err := time.Parse("2006-01-02", rate.Date)
+		if err != nil {
+			err = fmt.Errorf("rate %d: invalid date %q", i+1, rate.Date)
+			s.logger.Error("Invalid exchange rate", slog.Any("error", err))
+			return nil, err
+		}
+		rates = append(rates, exchange.Rate{
+			Base:  rate.BaseCurrency,
+			Quote: rate.QuoteCurrency,
+			Date:  date,
+			Rate:  rate.Rate,
+		})
+	}
+
+	count, err := s.SaveExchangeRates(ctx, rates, "manual")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertExchangeRatesResponse{Upserted: int32(count)}, nil
+}
+
+func (s *CurrencyStorage) GetExchangeRate(ctx context.Context, req *pb.GetExchangeRateRequest) (*pb.ExchangeRate, error) {
+	s.logger.Info("GetExchangeRate", slog.Any("req", req))
+
+	base, err := exchange.Currency(req.BaseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := exchange.Currency(req.QuoteCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now().UTC()
+	if req.Date != "" {
+		if date, err = time.Parse("2006-01-02", req.Date); err != nil {
+			s.logger.Error("Error parsing date", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	rate, source, err := findRate(ctx, s.mongodb, base, quote, date)
+	if err != nil {
+		if !errors.Is(err, exchange.ErrNoRate) {
+			s.logger.Error("Error while fetching exchange rate", slog.Any("error", err))
+		}
+		return nil, err
+	}
+
+	return &pb.ExchangeRate{
+		BaseCurrency:  rate.Base,
+		QuoteCurrency: rate.Quote,
+		Date:          rate.Date.Format("2006-01-02"),
+		Rate:          rate.Rate,
+		Source:        source,
+	}, nil
+}
+
+// SaveExchangeRates stores rates, replacing any rate already held for the
+// same currency pair and day.
+func (s *CurrencyStorage) SaveExchangeRates(ctx context.Context, rates []exchange.Rate, source string) (int, error) {
+	if len(rates) == 0 {
+		return 0, nil
+	}
+
+	updated_at := time.Now()
+	models := make([]mongo.WriteModel, 0, len(rates))
+	for _, rate := range rates {
+		if err := rate.Validate(); err != nil {
+			s.logger.Error("Invalid exchange rate", slog.Any("error", err))
+			return 0, err
+		}
+		base, _ := exchange.Currency(rate.Base)
+		quote, _ := exchange.Currency(rate.Quote)
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "base", Value: base},
+				{Key: "quote", Value: quote},
+				{Key: "date", Value: day(rate.Date)},
+			}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{
+				{Key: "rate", Value: rate.Rate},
+				{Key: "source", Value: source},
+				{Key: "updated_at", Value: updated_at},
+			}}}).
+			SetUpsert(true))
+	}
+
+	res, err := s.mongodb.Collection("exchange_rates").BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		s.logger.Error("Error while saving exchange rates", slog.Any("error", err))
+		return 0, err
+	}
+
+	s.logger.Info("Exchange rates saved", slog.String("source", source), slog.Int("count", len(rates)))
+	return int(res.MatchedCount + res.UpsertedCount), nil
+}
+
+// EnsureIndexes keeps one rate per currency pair and day, and serves the
+// latest-rate-before-a-date lookup.
+func (s *CurrencyStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("exchange_rates").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "base", Value: 1},
+			{Key: "quote", Value: 1},
+			{Key: "date", Value: -1},
+		},
+		Options: options.Index().SetName("exchange_rate_day").SetUnique(true),
+	})
+	if err != nil {
+		s.logger.Error("Error creating exchange rate index", slog.Any("error", err))
+		return err
+	}
+
+	_, err = s.mongodb.Collection("user_settings").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("user_settings_user").SetUnique(true),
+	})
+	if err != nil {
+		s.logger.Error("Error creating user settings index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// baseCurrency returns the currency the user's reports are kept in.
+func baseCurrency(ctx context.Context, db *mongo.Database, cfg *config.Config, userID string) (string, error) {
+	var settings struct {
+		BaseCurrency string `bson:"base_currency"`
+	}
+	err := db.Collection("user_settings").FindOne(ctx, bson.D{{Key: "user_id", Value: userID}}).Decode(&settings)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return "", err
+	}
+	if settings.BaseCurrency == "" {
+		return cfg.Currency.DefaultBase, nil
+	}
+	return settings.BaseCurrency, nil
+}
+
+// findRate returns the latest rate from one currency to another on or before
+// date. A rate stored the other way round is inverted; when both directions
+// exist the more recent one wins.
+func findRate(ctx context.Context, db *mongo.Database, from, to string, date time.Time) (*exchange.Rate, string, error) {
+	filter := bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "base", Value: from}, {Key: "quote", Value: to}},
+			bson.D{{Key: "base", Value: to}, {Key: "quote", Value: from}},
+		}},
+		{Key: "date", Value: bson.D{{Key: "$lte", Value: day(date)}}},
+	}
+
+	var stored struct {
+		Base   string    `bson:"base"`
+		Date   time.Time `bson:"date"`
+		Rate   float64   `bson:"rate"`
+		Source string    `bson:"source"`
+	}
+	err := db.Collection("exchange_rates").FindOne(ctx, filter, options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}})).Decode(&stored)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, "", fmt.Errorf("%w from %s to %s on or before %s", exchange.ErrNoRate, from, to, date.Format("2006-01-02"))
+		}
+		return nil, "", err
+	}
+
+	rate := &exchange.Rate{Base: from, Quote: to, Date: stored.Date.UTC(), Rate: stored.Rate}
+	if stored.Base != from {
+		rate.Rate = 1 / stored.Rate
+	}
+	return rate, stored.Source, nil
+}
+
+// rateLookup looks rates up in the exchange_rates collection.
+func rateLookup(db *mongo.Database) exchange.Lookup {
+	return func(ctx context.Context, from, to string, date time.Time) (float64, error) {
+		rate, _, err := findRate(ctx, db, from, to, date)
+		if err != nil {
+			return 0, err
+		}
+		return rate.Rate, nil
+	}
+}
+
+// day truncates t to midnight UTC, the key rates are stored under.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,425 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/goal"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/goaltrack"
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errAccountsGoal refuses to move a goal that tracks accounts by hand.
+var errAccountsGoal = status.Error(codes.FailedPrecondition, "the progress of a goal tracking accounts comes from their balances")
+
+func (s *GoalStorage) AddGoalContribution(ctx context.Context, req *pb.AddGoalContributionRequest) (*pb.GoalContribution, error) {
+	s.logger.Info("AddGoalContribution", slog.String("req", req.String()))
+
+	goal, err := s.liveGoal(ctx, req.GoalId)
+	if err != nil || goal == nil {
+		return nil, err
+	}
+	if goal.Tracking == goaltrack.Accounts {
+		return nil, errAccountsGoal
+	}
+
+	amount, err := money.Amount(req.Amount, goal.Currency)
+	if err != nil {
+		s.logger.Error("Invalid contribution amount", slog.Any("error", err))
+		return nil, err
+	}
+	amount, err = goaltrack.Signed(req.Type, amount)
+	if err != nil {
+		s.logger.Error("Invalid contribution", slog.Any("error", err))
+		return nil, err
+	}
+
+	if req.AccountId != "" {
+		if err := checkGoalAccounts(ctx, s.mongodb, goal.UserID, []string{req.AccountId}); err != nil {
+			s.logger.Error("Invalid contribution account", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	contributionDoc := bson.D{
+		{Key: "goal_id", Value: req.GoalId},
+		{Key: "user_id", Value: goal.UserID},
+		{Key: "amount", Value: amount},
+		{Key: "currency", Value: goal.Currency},
+		{Key: "source", Value: goaltrack.SourceManual},
+		{Key: "account_id", Value: req.AccountId},
+		{Key: "note", Value: req.Note},
+		{Key: "date", Value: now},
+		{Key: "created_at", Value: now},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	res, err := s.mongodb.Collection("goal_contributions").InsertOne(ctx, contributionDoc)
+	if err != nil {
+		s.logger.Error("Error while recording contribution", slog.Any("error", err))
+		return nil, err
+	}
+
+	kind := goaltrack.Deposit
+	if amount < 0 {
+		kind = goaltrack.Withdrawal
+	}
+
+	return &pb.GoalContribution{
+		Id:        res.InsertedID.(primitive.ObjectID).Hex(),
+		GoalId:    req.GoalId,
+		Amount:    money.ToProto(max(amount, -amount), goal.Currency),
+		Type:      kind,
+		Source:    goaltrack.SourceManual,
+		AccountId: req.AccountId,
+		Note:      req.Note,
+		CreatedAt: now.String(),
+	}, nil
+}
+
+func (s *GoalStorage) GetGoalContributions(ctx context.Context, req *pb.GetGoalContributionsRequest) (*pb.GoalContributionsResponse, error) {
+	s.logger.Info("GetGoalContributions", slog.String("req", req.String()))
+
+	filter := bson.D{
+		{Key: "goal_id", Value: req.GoalId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+
+	page, err := newPage("", "", map[string]string{
+		"created_at": "created_at",
+	}, "created_at", req.PageSize, req.PageToken)
+	if err != nil {
+		s.logger.Error("Invalid page request", slog.Any("error", err))
+		return nil, err
+	}
+
+	cursor, err := s.mongodb.Collection("goal_contributions").Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error("Error while retrieving contributions", slog.Any("error", err))
+		return nil, err
+	}
+
+	var docs []bson.M
+	if err = cursor.All(ctx, &docs); err != nil {
+		s.logger.Error("Error while decoding contribution", slog.Any("error", err))
+		return nil, err
+	}
+
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error("Error while building page token", slog.Any("error", err))
+		return nil, err
+	}
+
+	var contributions []*pb.GoalContribution
+	for _, contribution := range docs {
+		amount := storedAmount(contribution["amount"])
+		kind := goaltrack.Deposit
+		if amount < 0 {
+			kind = goaltrack.Withdrawal
+			amount = -amount
+		}
+
+		contributions = append(contributions, &pb.GoalContribution{
+			Id:            contribution["_id"].(primitive.ObjectID).Hex(),
+			GoalId:        storedString(contribution["goal_id"]),
+			Amount:        money.ToProto(amount, storedString(contribution["currency"])),
+			Type:          kind,
+			Source:        storedString(contribution["source"]),
+			AccountId:     storedString(contribution["account_id"]),
+			TransactionId: storedString(contribution["transaction_id"]),
+			Note:          storedString(contribution["note"]),
+			CreatedAt:     contribution["created_at"].(primitive.DateTime).Time().String(),
+		})
+	}
+
+	return &pb.GoalContributionsResponse{Contributions: contributions, NextPageToken: nextPageToken}, nil
+}
+
+// EnsureIndexes indexes the contribution ledger, with at most one live entry
+// per transaction.
+func (s *GoalStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("goal_contributions").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "goal_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("goal_contribution_created"),
+		},
+		{
+			Keys: bson.D{{Key: "transaction_id", Value: 1}},
+			Options: options.Index().SetName("goal_contribution_transaction").SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "transaction_id", Value: bson.D{{Key: "$type", Value: "string"}}}}),
+		},
+	})
+	if err != nil {
+		s.logger.Error("Error creating goal contribution indexes", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// MigrateGoalLedger gives every goal from before the contribution ledger
+// existed the entries its progress so far is made of. It has to run before
+// goals are served, since progress is read from the ledger; goals already
+// opened are left alone, so running it again is harmless.
+func MigrateGoalLedger(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	cursor, err := db.Collection("goals").Find(ctx, bson.D{
+		{Key: "ledger", Value: bson.D{{Key: "$exists", Value: false}}},
+	})
+	if err != nil {
+		return fmt.Errorf("finding goals without a ledger: %w", err)
+	}
+
+	var goals []bson.M
+	if err := cursor.All(ctx, &goals); err != nil {
+		return fmt.Errorf("decoding goals without a ledger: %w", err)
+	}
+
+	for _, doc := range goals {
+		goal := newProgressGoal(doc)
+		if err := openLedger(ctx, db, goal); err != nil {
+			return fmt.Errorf("opening the ledger of goal %s: %w", goal.ID.Hex(), err)
+		}
+	}
+	if len(goals) > 0 {
+		logger.Info("Opened goal ledgers", slog.Int("count", len(goals)))
+	}
+
+	return nil
+}
+
+// openLedger records the contributions a goal from before the ledger has
+// already had: its current amount if it is kept by hand, or its tagged
+// transactions. The goal is flagged in the same transaction, so it is opened
+// only once.
+func openLedger(ctx context.Context, db *mongo.Database, goal progressGoal) error {
+	return withTransaction(ctx, db, func(sc mongo.SessionContext) error {
+		res, err := db.Collection("goals").UpdateOne(sc, bson.D{
+			{Key: "_id", Value: goal.ID},
+			{Key: "ledger", Value: bson.D{{Key: "$exists", Value: false}}},
+		}, bson.D{{Key: "$set", Value: bson.D{{Key: "ledger", Value: true}}}})
+		if err != nil || res.ModifiedCount == 0 {
+			return err
+		}
+
+		switch goal.Tracking {
+		case goaltrack.Accounts:
+			return nil
+		case goaltrack.Contributions:
+			cursor, err := db.Collection("transactions").Find(sc, bson.D{
+				{Key: "goal_id", Value: goal.ID.Hex()},
+				{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+			})
+			if err != nil {
+				return err
+			}
+
+			var transactions []bson.M
+			if err := cursor.All(sc, &transactions); err != nil {
+				return err
+			}
+			for _, transaction := range transactions {
+				if err := syncGoalContribution(sc, db, transaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if goal.CurrentAmount == 0 {
+			return nil
+		}
+		_, err = db.Collection("goal_contributions").InsertOne(sc, openingContribution(goal, goal.CreatedAt))
+		return err
+	})
+}
+
+// openingContribution is the ledger entry for the amount a goal starts with.
+func openingContribution(goal progressGoal, date time.Time) bson.D {
+	return bson.D{
+		{Key: "goal_id", Value: goal.ID.Hex()},
+		{Key: "user_id", Value: goal.UserID},
+		{Key: "amount", Value: goal.CurrentAmount},
+		{Key: "currency", Value: goal.Currency},
+		{Key: "source", Value: goaltrack.SourceOpening},
+		{Key: "date", Value: date},
+		{Key: "created_at", Value: date},
+		{Key: "deleted_at", Value: nil},
+	}
+}
+
+// syncGoalContribution keeps the ledger entry of a transaction in line with
+// it: the entry follows the goal the transaction is tagged with, and goes away
+// with the transaction.
+func syncGoalContribution(ctx context.Context, db *mongo.Database, transaction bson.M) error {
+	contributionCollection := db.Collection("goal_contributions")
+	transactionID := transaction["_id"].(primitive.ObjectID).Hex()
+	now := time.Now()
+
+	goalID := storedString(transaction["goal_id"])
+	if goalID == "" || transaction["deleted_at"] != nil {
+		_, err := contributionCollection.UpdateMany(ctx, bson.D{
+			{Key: "transaction_id", Value: transactionID},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		}, bson.D{{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: now}}}})
+		return err
+	}
+
+	_, err := contributionCollection.UpdateOne(ctx,
+		bson.D{{Key: "transaction_id", Value: transactionID}},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "goal_id", Value: goalID},
+				{Key: "user_id", Value: transaction["user_id"]},
+				{Key: "amount", Value: storedAmount(transaction["amount"])},
+				{Key: "currency", Value: storedString(transaction["currency"])},
+				{Key: "source", Value: goaltrack.SourceTransaction},
+				{Key: "account_id", Value: transaction["account_id"]},
+				{Key: "note", Value: transaction["description"]},
+				{Key: "date", Value: transaction["date"]},
+				{Key: "deleted_at", Value: nil},
+			}},
+			{Key: "$setOnInsert", Value: bson.D{{Key: "created_at", Value: now}}},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// ledgerProgress adds up a goal's contributions, in the converter's currency,
+// and breaks them down by the account they came from.
+func ledgerProgress(ctx context.Context, db *mongo.Database, goal progressGoal, converter *exchange.Converter) (int64, map[string]int64, error) {
+	sources := make(map[string]int64)
+	var total int64
+
+	accounts := make(map[string]string)
+	err := eachContribution(ctx, db, goal, bson.D{}, converter, func(contribution bson.M, amount int64) error {
+		source := storedString(contribution["source"])
+		if accountID := storedString(contribution["account_id"]); accountID != "" {
+			name, ok := accounts[accountID]
+			if !ok {
+				var err error
+				if name, err = lookupAccountName(ctx, db, accountID); err != nil {
+					return err
+				}
+				accounts[accountID] = name
+			}
+			if name != "" {
+				source = name
+			}
+		}
+
+		sources[source] += amount
+		total += amount
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return total, sources, nil
+}
+
+// ledgerFlow is how much was paid into a goal, less withdrawals, from start to
+// now. The opening balance is not counted, since it was not paid in then.
+func ledgerFlow(ctx context.Context, db *mongo.Database, goal progressGoal, start, now time.Time, converter *exchange.Converter) (int64, error) {
+	var flow int64
+	err := eachContribution(ctx, db, goal, bson.D{
+		{Key: "source", Value: bson.D{{Key: "$ne", Value: goaltrack.SourceOpening}}},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lte", Value: now}}},
+	}, converter, func(_ bson.M, amount int64) error {
+		flow += amount
+		return nil
+	})
+	return flow, err
+}
+
+// eachContribution calls fn with every live contribution to a goal matching
+// filter, converted at the rate for its date.
+func eachContribution(ctx context.Context, db *mongo.Database, goal progressGoal, filter bson.D, converter *exchange.Converter, fn func(contribution bson.M, amount int64) error) error {
+	filter = append(bson.D{
+		{Key: "goal_id", Value: goal.ID.Hex()},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, filter...)
+
+	cursor, err := db.Collection("goal_contributions").Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var contribution bson.M
+		if err := cursor.Decode(&contribution); err != nil {
+			return err
+		}
+		date, _ := contribution["date"].(primitive.DateTime)
+		amount, err := converter.Convert(ctx, storedAmount(contribution["amount"]), storedString(contribution["currency"]), date.Time())
+		if err != nil {
+			return err
+		}
+		if err := fn(contribution, amount); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// lookupAccountName returns the name of an account, or "" when it is gone.
+func lookupAccountName(ctx context.Context, db *mongo.Database, accountID string) (string, error) {
+	objID, err := primitive.ObjectIDFromHex(accountID)
+	if err != nil {
+		return "", nil
+	}
+
+	var account struct {
+		Name string `bson:"name"`
+	}
+	err = db.Collection("accounts").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&account)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return "", err
+	}
+	return account.Name, nil
+}
+
+// liveGoal loads a goal that has not been deleted, or nil if there is none.
+func (s *GoalStorage) liveGoal(ctx context.Context, id string) (*progressGoal, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	var doc bson.M
+	err = s.mongodb.Collection("goals").FindOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.Info("Goal not found", slog.String("id", id))
+			return nil, nil
+		}
+		s.logger.Error("Error while retrieving goal", slog.Any("error", err))
+		return nil, err
+	}
+
+	goal := newProgressGoal(doc)
+	return &goal, nil
+}
@@ -0,0 +1,286 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/goaltrack"
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type progressGoal struct {
+	ID            primitive.ObjectID
+	UserID        string
+	TargetAmount  int64
+	CurrentAmount int64
+	Currency      string
+	Deadline      time.Time
+	Status        string
+	Tracking      string
+	AccountIDs    []string
+	AchievedAt    *time.Time
+	CreatedAt     time.Time
+	Pace          string
+	Name          string
+	Ledger        bool
+	Milestones    []goaltrack.Milestone
+}
+
+func newProgressGoal(goal bson.M) progressGoal {
+	progress := progressGoal{
+		UserID:        storedString(goal["user_id"]),
+		TargetAmount:  storedAmount(goal["target_amount"]),
+		CurrentAmount: storedAmount(goal["current_amount"]),
+		Currency:      storedString(goal["currency"]),
+		Status:        storedString(goal["status"]),
+		Tracking:      storedString(goal["tracking"]),
+		Pace:          storedString(goal["pace"]),
+		Name:          storedString(goal["name"]),
+		Milestones:    storedMilestones(goal),
+	}
+	progress.Ledger, _ = goal["ledger"].(bool)
+	progress.ID, _ = goal["_id"].(primitive.ObjectID)
+	if deadline, ok := goal["deadline"].(primitive.DateTime); ok {
+		progress.Deadline = deadline.Time()
+	}
+	if createdAt, ok := goal["created_at"].(primitive.DateTime); ok {
+		progress.CreatedAt = createdAt.Time()
+	}
+	if achievedAt, ok := goal["achieved_at"].(primitive.DateTime); ok {
+		t := achievedAt.Time()
+		progress.AchievedAt = &t
+	}
+	if accountIDs, ok := goal["account_ids"].(bson.A); ok {
+		for _, accountID := range accountIDs {
+			progress.AccountIDs = append(progress.AccountIDs, storedString(accountID))
+		}
+	}
+	return progress
+}
+
+// RefreshGoalProgress recomputes the progress and status of the user's goals
+// and returns an alert for each goal that has just been achieved for the first
+// time, or else has just passed one or more milestones. Achievements and
+// milestones are claimed by setting their reached time only where it is still
+// unset, so concurrent refreshes report each once.
+func (s *GoalStorage) RefreshGoalProgress(ctx context.Context, userID string) ([]*goaltrack.Alert, error) {
+	goalCollection := s.mongodb.Collection("goals")
+
+	cursor, err := goalCollection.Find(ctx, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	if err != nil {
+		s.logger.Error("Error while retrieving goals", slog.Any("error", err))
+		return nil, err
+	}
+
+	var goals []bson.M
+	if err := cursor.All(ctx, &goals); err != nil {
+		s.logger.Error("Error while decoding goal", slog.Any("error", err))
+		return nil, err
+	}
+
+	now := time.Now()
+	var alerts []*goaltrack.Alert
+	for _, doc := range goals {
+		goal := newProgressGoal(doc)
+		converter := exchange.NewConverter(goal.Currency, rateLookup(s.mongodb))
+		current, _, err := goalProgress(ctx, s.mongodb, goal, converter, now)
+		if err != nil {
+			s.logger.Error("Error while computing goal progress", slog.String("id", goal.ID.Hex()), slog.Any("error", err))
+			return nil, err
+		}
+
+		status := goaltrack.Status(current, goal.TargetAmount, goal.Deadline, now, goal.Status)
+		reached := goaltrack.Reached(goal.Milestones, goal.TargetAmount, current)
+		if current == goal.CurrentAmount && status == goal.Status && len(reached) == 0 {
+			continue
+		}
+
+		alert := &goaltrack.Alert{
+			GoalID:   goal.ID.Hex(),
+			UserID:   goal.UserID,
+			Name:     goal.Name,
+			Current:  current,
+			Target:   goal.TargetAmount,
+			Deadline: goal.Deadline,
+			Currency: goal.Currency,
+		}
+
+		set := bson.D{
+			{Key: "current_amount", Value: current},
+			{Key: "status", Value: status},
+			{Key: "updated_at", Value: now},
+		}
+		filter := bson.D{{Key: "_id", Value: goal.ID}}
+
+		if _, err := goalCollection.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: set}}); err != nil {
+			s.logger.Error("Error while updating goal progress", slog.Any("error", err))
+			return nil, err
+		}
+
+		var newlyReached []goaltrack.Milestone
+		for _, i := range reached {
+			res, err := goalCollection.UpdateOne(ctx,
+				append(filter, bson.E{Key: "milestones", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+					{Key: "id", Value: goal.Milestones[i].ID},
+					{Key: "reached_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+				}}}}),
+				bson.D{{Key: "$set", Value: bson.D{{Key: "milestones.$.reached_at", Value: now}}}},
+			)
+			if err != nil {
+				s.logger.Error("Error while updating goal milestone", slog.Any("error", err))
+				return nil, err
+			}
+			if res.ModifiedCount == 1 {
+				newlyReached = append(newlyReached, goal.Milestones[i])
+			}
+		}
+		alerts = append(alerts, alert.MilestoneAlerts(newlyReached)...)
+
+		if status == goaltrack.Achieved && goal.AchievedAt == nil {
+			res, err := goalCollection.UpdateOne(ctx,
+				append(filter, bson.E{Key: "achieved_at", Value: bson.D{{Key: "$eq", Value: nil}}}),
+				bson.D{{Key: "$set", Value: bson.D{{Key: "achieved_at", Value: now}}}},
+			)
+			if err != nil {
+				s.logger.Error("Error while updating goal progress", slog.Any("error", err))
+				return nil, err
+			}
+			if res.ModifiedCount == 1 {
+				alert.Kind = goaltrack.AlertAchieved
+				alerts = append(alerts, alert)
+			}
+		}
+	}
+
+	return alerts, nil
+}
+
+// MarkMissedGoals moves goals whose deadline has passed short of their target
+// from in progress to missed.
+func (s *GoalStorage) MarkMissedGoals(ctx context.Context, now time.Time) (int, error) {
+	res, err := s.mongodb.Collection("goals").UpdateMany(ctx, bson.D{
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{"", goaltrack.InProgress}}}},
+		{Key: "deadline", Value: bson.D{{Key: "$lt", Value: day(now)}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "$expr", Value: bson.D{{Key: "$lt", Value: bson.A{"$current_amount", "$target_amount"}}}},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: goaltrack.Missed},
+		{Key: "updated_at", Value: now},
+	}}})
+	if err != nil {
+		s.logger.Error("Error while marking missed goals", slog.Any("error", err))
+		return 0, err
+	}
+
+	return int(res.ModifiedCount), nil
+}
+
+// goalProgress works out how far a goal has come, in the converter's currency,
+// together with what each linked or contributing account adds to it. Goals
+// tracking accounts are as far as their balances; every other goal is as far
+// as its contribution ledger adds up to.
+func goalProgress(ctx context.Context, db *mongo.Database, goal progressGoal, converter *exchange.Converter, now time.Time) (int64, map[string]int64, error) {
+	sources := make(map[string]int64)
+	var total int64
+
+	switch goal.Tracking {
+	case goaltrack.Accounts:
+		var objIDs bson.A
+		for _, accountID := range goal.AccountIDs {
+			objID, err := primitive.ObjectIDFromHex(accountID)
+			if err != nil {
+				continue
+			}
+			objIDs = append(objIDs, objID)
+		}
+
+		cursor, err := db.Collection("accounts").Find(ctx, bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: objIDs}}},
+			{Key: "user_id", Value: goal.UserID},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		var accounts []bson.M
+		if err := cursor.All(ctx, &accounts); err != nil {
+			return 0, nil, err
+		}
+
+		for _, account := range accounts {
+			balance, err := converter.Convert(ctx, storedAmount(account["balance"]), storedString(account["currency"]), now)
+			if err != nil {
+				return 0, nil, err
+			}
+			sources[storedString(account["name"])] += balance
+			total += balance
+		}
+
+	default:
+		if goal.Ledger {
+			return ledgerProgress(ctx, db, goal, converter)
+		}
+		current, err := converter.Convert(ctx, goal.CurrentAmount, goal.Currency, now)
+		if err != nil {
+			return 0, nil, err
+		}
+		total = current
+	}
+
+	return total, sources, nil
+}
+
+// checkGoalAccounts makes sure every account a goal links to is the user's.
+func checkGoalAccounts(ctx context.Context, db *mongo.Database, userID string, accountIDs []string) error {
+	for _, accountID := range accountIDs {
+		objID, err := primitive.ObjectIDFromHex(accountID)
+		if err != nil {
+			return fmt.Errorf("invalid account id %q: %w", accountID, err)
+		}
+
+		count, err := db.Collection("accounts").CountDocuments(ctx, bson.D{
+			{Key: "_id", Value: objID},
+			{Key: "user_id", Value: userID},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("account %s not found", accountID)
+		}
+	}
+	return nil
+}
+
+// checkGoal makes sure a transaction is tagged with one of the user's goals.
+func checkGoal(ctx context.Context, db *mongo.Database, userID, goalID string) error {
+	objID, err := primitive.ObjectIDFromHex(goalID)
+	if err != nil {
+		return validation.Field("goal_id", "%q is not a valid id", goalID)
+	}
+
+	count, err := db.Collection("goals").CountDocuments(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return validation.Field("goal_id", "goal %s not found", goalID)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "budgeting-service/genproto/goal"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/goaltrack"
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (s *GoalStorage) ProjectGoal(ctx context.Context, req *pb.ProjectGoalRequest) (*pb.GoalProjectionResponse, error) {
+	s.logger.Info("ProjectGoal", slog.String("id", req.Id))
+
+	goal, err := s.liveGoal(ctx, req.Id)
+	if err != nil || goal == nil {
+		return nil, err
+	}
+
+	projection, err := projectGoal(ctx, s.mongodb, *goal, time.Now())
+	if err != nil {
+		s.logger.Error("Error while projecting goal", slog.Any("error", err))
+		return nil, err
+	}
+
+	response := &pb.GoalProjectionResponse{
+		GoalId:          req.Id,
+		Remaining:       money.ToProto(projection.Remaining, goal.Currency),
+		MonthlyRequired: money.ToProto(projection.MonthlyRequired, goal.Currency),
+		WeeklyRequired:  money.ToProto(projection.WeeklyRequired, goal.Currency),
+		MonthlyRate:     money.ToProto(projection.MonthlyRate, goal.Currency),
+		Pace:            projection.Pace,
+	}
+	if !projection.Completion.IsZero() {
+		response.ProjectedCompletionDate = projection.Completion.Format("2006-01-02")
+	}
+
+	return response, nil
+}
+
+// CheckGoalPace projects every goal still in progress, of the given user or of
+// everyone when userID is empty, and records its pace. It returns an alert for
+// each goal that has just fallen at risk; the pace is switched with a
+// compare-and-set so the same fall is reported once.
+func (s *GoalStorage) CheckGoalPace(ctx context.Context, userID string, now time.Time) ([]*goaltrack.Alert, error) {
+	goalCollection := s.mongodb.Collection("goals")
+
+	filter := bson.D{
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{"", goaltrack.InProgress}}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if userID != "" {
+		filter = append(filter, bson.E{Key: "user_id", Value: userID})
+	}
+
+	cursor, err := goalCollection.Find(ctx, filter)
+	if err != nil {
+		s.logger.Error("Error while retrieving goals", slog.Any("error", err))
+		return nil, err
+	}
+
+	var goals []bson.M
+	if err := cursor.All(ctx, &goals); err != nil {
+		s.logger.Error("Error while decoding goal", slog.Any("error", err))
+		return nil, err
+	}
+
+	var alerts []*goaltrack.Alert
+	for _, doc := range goals {
+		goal := newProgressGoal(doc)
+
+		projection, err := projectGoal(ctx, s.mongodb, goal, now)
+		if err != nil {
+			s.logger.Error("Error while projecting goal", slog.String("id", goal.ID.Hex()), slog.Any("error", err))
+			return nil, err
+		}
+		if projection.Pace == goal.Pace {
+			continue
+		}
+
+		res, err := goalCollection.UpdateOne(ctx, bson.D{
+			{Key: "_id", Value: goal.ID},
+			{Key: "pace", Value: bson.D{{Key: "$ne", Value: projection.Pace}}},
+		}, bson.D{{Key: "$set", Value: bson.D{{Key: "pace", Value: projection.Pace}}}})
+		if err != nil {
+			s.logger.Error("Error while recording goal pace", slog.Any("error", err))
+			return nil, err
+		}
+
+		if projection.Pace == goaltrack.AtRisk && res.ModifiedCount == 1 {
+			alerts = append(alerts, &goaltrack.Alert{
+				Kind:       goaltrack.AlertAtRisk,
+				GoalID:     goal.ID.Hex(),
+				UserID:     goal.UserID,
+				Name:       goal.Name,
+				Target:     goal.TargetAmount,
+				Deadline:   goal.Deadline,
+				Currency:   goal.Currency,
+				Projection: projection,
+			})
+		}
+	}
+
+	return alerts, nil
+}
+
+// projectGoal projects a goal from its current progress and what was added to
+// it over the recent window. A goal from before the contribution ledger has no
+// history to go on, so its whole current amount is spread over its lifetime
+// instead.
+func projectGoal(ctx context.Context, db *mongo.Database, goal progressGoal, now time.Time) (goaltrack.Projection, error) {
+	converter := exchange.NewConverter(goal.Currency, rateLookup(db))
+
+	current, _, err := goalProgress(ctx, db, goal, converter, now)
+	if err != nil {
+		return goaltrack.Projection{}, err
+	}
+
+	today := day(now)
+	start := day(goal.CreatedAt)
+	if recent := today.AddDate(0, 0, -goaltrack.RecentWindow); start.Before(recent) && (goal.Ledger || goal.Tracking == goaltrack.Accounts) {
+		start = recent
+	}
+	window := int(today.Sub(start).Hours() / 24)
+
+	contributed := current
+	switch {
+	case goal.Tracking == goaltrack.Accounts:
+		contributed, err = accountsFlow(ctx, db, goal, start, now, converter)
+	case goal.Ledger:
+		contributed, err = ledgerFlow(ctx, db, goal, start, now, converter)
+	}
+	if err != nil {
+		return goaltrack.Projection{}, err
+	}
+
+	return goaltrack.Project(goal.TargetAmount, current, goal.Deadline, now, contributed, window), nil
+}
+
+// accountsFlow is how much the balances of a goal's accounts have moved by
+// since start.
+func accountsFlow(ctx context.Context, db *mongo.Database, goal progressGoal, start, now time.Time, converter *exchange.Converter) (int64, error) {
+	cursor, err := db.Collection("transactions").Find(ctx, bson.D{
+		{Key: "user_id", Value: goal.UserID},
+		{Key: "account_id", Value: bson.D{{Key: "$in", Value: goal.AccountIDs}}},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lte", Value: now}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var flow int64
+	for cursor.Next(ctx) {
+		var transaction bson.M
+		if err := cursor.Decode(&transaction); err != nil {
+			return 0, err
+		}
+		date, _ := transaction["date"].(primitive.DateTime)
+		amount, err := converter.Convert(ctx, storedAmount(transaction["amount"]), storedString(transaction["currency"]), date.Time())
+		if err != nil {
+			return 0, err
+		}
+		flow += balanceDelta(storedString(transaction["type"]), amount)
+	}
+
+	return flow, cursor.Err()
+}
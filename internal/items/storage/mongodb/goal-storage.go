@@ -2,8 +2,13 @@ package mongodb
 
 import (
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/goaltrack"
+	"budgeting-service/internal/items/money"
 	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/validation"
 	"context"
+	"errors"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -42,35 +47,89 @@ func (s *GoalStorage) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest)
 		return nil, err
 	}
 
+	currency := req.TargetAmount.GetCurrencyCode()
+	targetAmount, err := money.FromProto(req.TargetAmount)
+	if err != nil {
+		s.logger.Error("Invalid target amount", slog.Any("error", err))
+		return nil, err
+	}
+	currentAmount, err := money.Amount(req.CurrentAmount, currency)
+	if err != nil {
+		s.logger.Error("Invalid current amount", slog.Any("error", err))
+		return nil, err
+	}
+
+	tracking, err := goaltrack.Tracking(req.Tracking, req.AccountIds)
+	if err != nil {
+		s.logger.Error("Invalid goal tracking", slog.Any("error", err))
+		return nil, err
+	}
+	if err := checkGoalAccounts(ctx, s.mongodb, req.UserId, req.AccountIds); err != nil {
+		s.logger.Error("Invalid goal accounts", slog.Any("error", err))
+		return nil, err
+	}
+	milestones, err := checkMilestones(req.Milestones, targetAmount, currentAmount, currency, created_at)
+	if err != nil {
+		s.logger.Error("Invalid milestones", slog.Any("error", err))
+		return nil, err
+	}
+	status := goaltrack.Status(currentAmount, targetAmount, deadline, created_at, req.Status)
+
 	goalDoc := bson.D{
 		{Key: "user_id", Value: req.UserId},
 		{Key: "name", Value: req.Name},
-		{Key: "target_amount", Value: req.TargetAmount},
-		{Key: "current_amount", Value: req.CurrentAmount},
+		{Key: "target_amount", Value: targetAmount},
+		{Key: "current_amount", Value: currentAmount},
+		{Key: "currency", Value: currency},
 		{Key: "deadline", Value: deadline},
-		{Key: "status", Value: req.Status},
+		{Key: "status", Value: status},
+		{Key: "tracking", Value: tracking},
+		{Key: "account_ids", Value: req.AccountIds},
+		{Key: "milestones", Value: milestoneDocs(milestones)},
+		{Key: "ledger", Value: true},
 		{Key: "created_at", Value: created_at},
 		{Key: "updated_at", Value: created_at},
 		{Key: "deleted_at", Value: nil},
 	}
 
-	res, err := goalCollecton.InsertOne(ctx, goalDoc)
+	var goalID string
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		res, err := goalCollecton.InsertOne(sc, goalDoc)
+		if err != nil {
+			return err
+		}
+
+		goal := progressGoal{
+			ID:            res.InsertedID.(primitive.ObjectID),
+			UserID:        req.UserId,
+			CurrentAmount: currentAmount,
+			Currency:      currency,
+		}
+		goalID = goal.ID.Hex()
+		if currentAmount == 0 || tracking == goaltrack.Accounts {
+			return nil
+		}
+
+		_, err = s.mongodb.Collection("goal_contributions").InsertOne(sc, openingContribution(goal, created_at))
+		return err
+	})
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
-	goalID := res.InsertedID.(primitive.ObjectID).Hex()
-
 	return &pb.GoalResponse{
 		Id:            goalID,
 		UserId:        req.UserId,
 		Name:          req.Name,
-		TargetAmount:  req.TargetAmount,
-		CurrentAmount: req.CurrentAmount,
+		TargetAmount:  money.ToProto(targetAmount, currency),
+		CurrentAmount: money.ToProto(currentAmount, currency),
 		Deadline:      req.Deadline,
-		Status:        req.Status,
+		Status:        status,
 		CreatedAt:     created_at.String(),
+		Tracking:      tracking,
+		AccountIds:    req.AccountIds,
+		Milestones:    milestoneResponses(milestones, targetAmount, currency),
 	}, nil
 }
 
@@ -78,42 +137,54 @@ func (s *GoalStorage) GetGoals(ctx context.Context, req *pb.GetGoalsRequest) (*p
 	s.logger.Info("GetGoals", slog.String("req", req.String()))
 	goalCollection := s.mongodb.Collection("goals")
 
-	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if req.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: req.Status})
+	}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(req.Search)},
+			{Key: "$options", Value: "i"},
+		}})
+	}
 
-	cursor, err := goalCollection.Find(ctx, filter)
+	page, err := newPage(req.SortBy, req.SortOrder, map[string]string{
+		"deadline":      "deadline",
+		"target_amount": "target_amount",
+		"created_at":    "created_at",
+	}, "created_at", req.PageSize, req.PageToken)
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var goals []*pb.GoalResponse
-	for cursor.Next(ctx) {
-		var goal bson.M
-		if err := cursor.Decode(&goal); err != nil {
-			s.logger.Error(err.Error())
-			return nil, err
-		}
+	cursor, err := goalCollection.Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
 
-		goals = append(goals, &pb.GoalResponse{
-			Id:            goal["_id"].(primitive.ObjectID).Hex(),
-			UserId:        goal["user_id"].(string),
-			Name:          goal["name"].(string),
-			TargetAmount:  float32(goal["target_amount"].(float64)),
-			CurrentAmount: float32(goal["current_amount"].(float64)),
-			Deadline:      goal["deadline"].(primitive.DateTime).Time().String(),
-			Status:        goal["status"].(string),
-			CreatedAt:     goal["created_at"].(primitive.DateTime).Time().String(),
-			UpdatedAt:     goal["updated_at"].(primitive.DateTime).Time().String(),
-		})
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
-	return &pb.GoalsResponse{Goals: goals}, nil
+	var goals []*pb.GoalResponse
+	for _, goal := range docs {
+		goals = append(goals, goalResponse(goal))
+	}
+
+	return &pb.GoalsResponse{Goals: goals, NextPageToken: nextPageToken}, nil
 }
 
 func (s *GoalStorage) GetGoalById(ctx context.Context, req *pb.GetGoalByIdRequest) (*pb.GoalResponse, error) {
@@ -139,31 +210,21 @@ func (s *GoalStorage) GetGoalById(ctx context.Context, req *pb.GetGoalByIdReques
 		return nil, err
 	}
 
-	return &pb.GoalResponse{
-		Id:            goal["_id"].(primitive.ObjectID).Hex(),
-		UserId:        goal["user_id"].(string),
-		Name:          goal["name"].(string),
-		TargetAmount:  float32(goal["target_amount"].(float64)),
-		CurrentAmount: float32(goal["current_amount"].(float64)),
-		Deadline:      goal["deadline"].(primitive.DateTime).Time().String(),
-		Status:        goal["status"].(string),
-		CreatedAt:     goal["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt:     goal["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return goalResponse(goal), nil
 }
 
 func (s *GoalStorage) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.GoalResponse, error) {
 	s.logger.Info("UpdateGoal", slog.String("req", req.String()))
 	goalCollection := s.mongodb.Collection("goals")
+	now := time.Now()
 
-	objID, err := primitive.ObjectIDFromHex(req.Id)
-	if err != nil {
-		s.logger.Error(err.Error())
+	stored, err := s.liveGoal(ctx, req.Id)
+	if err != nil || stored == nil {
 		return nil, err
 	}
 
 	filter := bson.D{
-		{Key: "_id", Value: objID},
+		{Key: "_id", Value: stored.ID},
 		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
 	}
 
@@ -171,11 +232,18 @@ func (s *GoalStorage) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest)
 	if req.Name != "" {
 		updateFields = append(updateFields, bson.E{Key: "name", Value: req.Name})
 	}
-	if req.TargetAmount != 0 {
-		updateFields = append(updateFields, bson.E{Key: "target_amount", Value: req.TargetAmount})
-	}
-	if req.CurrentAmount != 0 {
-		updateFields = append(updateFields, bson.E{Key: "current_amount", Value: req.CurrentAmount})
+	targetAmount, currency := stored.TargetAmount, stored.Currency
+	if req.TargetAmount != nil {
+		targetAmount, err = money.FromProto(req.TargetAmount)
+		if err != nil {
+			s.logger.Error("Invalid target amount", slog.Any("error", err))
+			return nil, err
+		}
+		updateFields = append(updateFields, bson.E{Key: "target_amount", Value: targetAmount})
+		if req.TargetAmount.CurrencyCode != "" {
+			currency = req.TargetAmount.CurrencyCode
+			updateFields = append(updateFields, bson.E{Key: "currency", Value: currency})
+		}
 	}
 	if req.Deadline != "" {
 		deadline, err := time.Parse("2006-01-02", req.Deadline)
@@ -188,44 +256,94 @@ func (s *GoalStorage) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest)
 	if req.Status != "" {
 		updateFields = append(updateFields, bson.E{Key: "status", Value: req.Status})
 	}
-	if len(updateFields) > 0 {
-		updateFields = append(updateFields, bson.E{Key: "updated_at", Value: time.Now()})
+	tracking := stored.Tracking
+	if req.Tracking != "" || len(req.AccountIds) > 0 {
+		accountIDs := req.AccountIds
+		if len(accountIDs) == 0 {
+			accountIDs = stored.AccountIDs
+		}
+		tracking, err = goaltrack.Tracking(req.Tracking, accountIDs)
+		if err != nil {
+			s.logger.Error("Invalid goal tracking", slog.Any("error", err))
+			return nil, err
+		}
+		if err := checkGoalAccounts(ctx, s.mongodb, stored.UserID, req.AccountIds); err != nil {
+			s.logger.Error("Invalid goal accounts", slog.Any("error", err))
+			return nil, err
+		}
+		updateFields = append(updateFields,
+			bson.E{Key: "tracking", Value: tracking},
+			bson.E{Key: "account_ids", Value: accountIDs},
+		)
 	}
 
-	if len(updateFields) == 0 {
+	// The current amount is no longer stored as given: setting it records the
+	// difference in the goal's ledger, which the amount is worked out from.
+	var adjustment int64
+	if req.CurrentAmount != nil {
+		if tracking == goaltrack.Accounts {
+			return nil, errAccountsGoal
+		}
+		// The stored amount is in the old currency, so the difference
+		// between the two would mean nothing.
+		if currency != stored.Currency {
+			return nil, validation.Field("current_amount", "cannot be set while target_amount changes currency from %s to %s", stored.Currency, currency)
+		}
+		currentAmount, err := money.Amount(req.CurrentAmount, currency)
+		if err != nil {
+			s.logger.Error("Invalid current amount", slog.Any("error", err))
+			return nil, err
+		}
+		adjustment = currentAmount - stored.CurrentAmount
+	}
+	if len(req.Milestones) > 0 {
+		milestones, err := checkMilestones(req.Milestones, targetAmount, stored.CurrentAmount+adjustment, currency, now)
+		if err != nil {
+			s.logger.Error("Invalid milestones", slog.Any("error", err))
+			return nil, err
+		}
+		updateFields = append(updateFields, bson.E{Key: "milestones", Value: milestoneDocs(milestones)})
+	}
+
+	if len(updateFields) == 0 && adjustment == 0 {
 		s.logger.Info("No fields to update")
 		return nil, nil
 	}
+	updateFields = append(updateFields, bson.E{Key: "updated_at", Value: now})
 
-	update := bson.D{{Key: "$set", Value: updateFields}}
+	var updatedGoal bson.M
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		update := bson.D{{Key: "$set", Value: updateFields}}
+		res := goalCollection.FindOneAndUpdate(sc, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+		if err := res.Decode(&updatedGoal); err != nil {
+			return err
+		}
+		if adjustment == 0 {
+			return nil
+		}
 
-	res := goalCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
-	if res.Err() != nil {
-		if res.Err() == mongo.ErrNoDocuments {
-			s.logger.Error(res.Err().Error())
+		_, err := s.mongodb.Collection("goal_contributions").InsertOne(sc, bson.D{
+			{Key: "goal_id", Value: req.Id},
+			{Key: "user_id", Value: stored.UserID},
+			{Key: "amount", Value: adjustment},
+			{Key: "currency", Value: currency},
+			{Key: "source", Value: goaltrack.SourceAdjustment},
+			{Key: "date", Value: now},
+			{Key: "created_at", Value: now},
+			{Key: "deleted_at", Value: nil},
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.Info("Goal not found", slog.String("id", req.Id))
 			return nil, nil
 		}
-		s.logger.Error(res.Err().Error())
-		return nil, res.Err()
-	}
-
-	var updatedGoal bson.M
-	if err = res.Decode(&updatedGoal); err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
-	return &pb.GoalResponse{
-		Id:            updatedGoal["_id"].(primitive.ObjectID).Hex(),
-		UserId:        updatedGoal["user_id"].(string),
-		Name:          updatedGoal["name"].(string),
-		TargetAmount:  float32(updatedGoal["target_amount"].(float64)),
-		CurrentAmount: float32(updatedGoal["current_amount"].(float64)),
-		Deadline:      updatedGoal["deadline"].(primitive.DateTime).Time().String(),
-		Status:        updatedGoal["status"].(string),
-		CreatedAt:     updatedGoal["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt:     updatedGoal["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return goalResponse(updatedGoal), nil
 }
 
 func (s *GoalStorage) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*pb.Empty, error) {
@@ -253,3 +371,134 @@ func (s *GoalStorage) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest)
 
 	return &pb.Empty{}, nil
 }
+
+func goalResponse(goal bson.M) *pb.GoalResponse {
+	currency := storedString(goal["currency"])
+	tracking := storedString(goal["tracking"])
+	if tracking == "" {
+		tracking = goaltrack.Manual
+	}
+
+	response := &pb.GoalResponse{
+		Id:            goal["_id"].(primitive.ObjectID).Hex(),
+		UserId:        goal["user_id"].(string),
+		Name:          goal["name"].(string),
+		TargetAmount:  money.ToProto(storedAmount(goal["target_amount"]), currency),
+		CurrentAmount: money.ToProto(storedAmount(goal["current_amount"]), currency),
+		Deadline:      goal["deadline"].(primitive.DateTime).Time().String(),
+		Status:        goal["status"].(string),
+		CreatedAt:     goal["created_at"].(primitive.DateTime).Time().String(),
+		UpdatedAt:     goal["updated_at"].(primitive.DateTime).Time().String(),
+		Tracking:      tracking,
+	}
+	if accountIDs, ok := goal["account_ids"].(bson.A); ok {
+		for _, accountID := range accountIDs {
+			response.AccountIds = append(response.AccountIds, storedString(accountID))
+		}
+	}
+	if achievedAt, ok := goal["achieved_at"].(primitive.DateTime); ok {
+		response.AchievedAt = achievedAt.Time().String()
+	}
+	response.Milestones = milestoneResponses(storedMilestones(goal), storedAmount(goal["target_amount"]), currency)
+
+	return response
+}
+
+// checkMilestones checks the milestones of a goal and gives each an id.
+// Milestones the goal is already past count as reached now, so setting them
+// does not set off a notification for each.
+func checkMilestones(milestones []*pb.Milestone, target, current int64, currency string, now time.Time) ([]goaltrack.Milestone, error) {
+	var checked []goaltrack.Milestone
+	for _, m := range milestones {
+		amount, err := money.Amount(m.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		milestone := goaltrack.Milestone{
+			ID:      primitive.NewObjectID().Hex(),
+			Percent: m.Percent,
+			Amount:  amount,
+		}
+		if m.TargetDate != "" {
+			if milestone.TargetDate, err = time.Parse("2006-01-02", m.TargetDate); err != nil {
+				return nil, err
+			}
+		}
+		if current >= milestone.Threshold(target) {
+			milestone.ReachedAt = now
+		}
+		checked = append(checked, milestone)
+	}
+
+	return goaltrack.Milestones(checked, target)
+}
+
+func milestoneDocs(milestones []goaltrack.Milestone) bson.A {
+	docs := bson.A{}
+	for _, m := range milestones {
+		var targetDate, reachedAt interface{}
+		if !m.TargetDate.IsZero() {
+			targetDate = m.TargetDate
+		}
+		if !m.ReachedAt.IsZero() {
+			reachedAt = m.ReachedAt
+		}
+
+		docs = append(docs, bson.D{
+			{Key: "id", Value: m.ID},
+			{Key: "percent", Value: m.Percent},
+			{Key: "amount", Value: m.Amount},
+			{Key: "target_date", Value: targetDate},
+			{Key: "reached_at", Value: reachedAt},
+		})
+	}
+	return docs
+}
+
+func storedMilestones(goal bson.M) []goaltrack.Milestone {
+	docs, _ := goal["milestones"].(bson.A)
+
+	var milestones []goaltrack.Milestone
+	for _, doc := range docs {
+		m, ok := doc.(bson.M)
+		if !ok {
+			continue
+		}
+
+		milestone := goaltrack.Milestone{
+			ID:     storedString(m["id"]),
+			Amount: storedAmount(m["amount"]),
+		}
+		if percent, ok := m["percent"].(int32); ok {
+			milestone.Percent = percent
+		}
+		if targetDate, ok := m["target_date"].(primitive.DateTime); ok {
+			milestone.TargetDate = targetDate.Time()
+		}
+		if reachedAt, ok := m["reached_at"].(primitive.DateTime); ok {
+			milestone.ReachedAt = reachedAt.Time()
+		}
+		milestones = append(milestones, milestone)
+	}
+	return milestones
+}
+
+func milestoneResponses(milestones []goaltrack.Milestone, target int64, currency string) []*pb.Milestone {
+	var responses []*pb.Milestone
+	for _, m := range milestones {
+		response := &pb.Milestone{
+			Id:      m.ID,
+			Percent: m.Percent,
+			Amount:  money.ToProto(m.Threshold(target), currency),
+		}
+		if !m.TargetDate.IsZero() {
+			response.TargetDate = m.TargetDate.UTC().Format("2006-01-02")
+		}
+		if !m.ReachedAt.IsZero() {
+			response.ReachedAt = m.ReachedAt.String()
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
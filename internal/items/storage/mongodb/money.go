@@ -0,0 +1,123 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// storedAmount reads a money field as minor units. Amounts are written as
+// int64, but documents saved before that held float64 units.
+func storedAmount(value interface{}) int64 {
+	switch amount := value.(type) {
+	case int64:
+		return amount
+	case int32:
+		return int64(amount)
+	case float64:
+		return money.FromFloat(amount)
+	}
+	return 0
+}
+
+func storedString(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
+
+// moneyFields lists the money fields of every collection that stores amounts.
+var moneyFields = map[string][]string{
+	"accounts":               {"balance"},
+	"budgets":                {"amount", "carry_over"},
+	"goals":                  {"target_amount", "current_amount"},
+	"transactions":           {"amount"},
+	"recurring_transactions": {"amount"},
+}
+
+// MigrateMoney rewrites amounts stored as float64 units into int64 minor units
+// and fills in the currency of transactions from their account. It only
+// touches documents still in the old shape, so running it again is harmless.
+func MigrateMoney(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	for collection, fields := range moneyFields {
+		for _, field := range fields {
+			res, err := db.Collection(collection).UpdateMany(ctx,
+				bson.D{{Key: field, Value: bson.D{{Key: "$type", Value: "double"}}}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.D{{Key: field, Value: toMinorUnits("$" + field)}}}}},
+			)
+			if err != nil {
+				return fmt.Errorf("migrating %s.%s: %w", collection, field, err)
+			}
+			if res.ModifiedCount > 0 {
+				logger.Info("Migrated money field", slog.String("field", collection+"."+field), slog.Int64("count", res.ModifiedCount))
+			}
+		}
+	}
+
+	splitAmount := bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$type", Value: "$$split.amount"}}, "double"}}},
+		toMinorUnits("$$split.amount"),
+		"$$split.amount",
+	}}}
+	splits := bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: "$splits"},
+		{Key: "as", Value: "split"},
+		{Key: "in", Value: bson.D{{Key: "$mergeObjects", Value: bson.A{"$$split", bson.D{{Key: "amount", Value: splitAmount}}}}}},
+	}}}
+	res, err := db.Collection("transactions").UpdateMany(ctx,
+		bson.D{{Key: "splits.amount", Value: bson.D{{Key: "$type", Value: "double"}}}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.D{{Key: "splits", Value: splits}}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("migrating transactions.splits: %w", err)
+	}
+	if res.ModifiedCount > 0 {
+		logger.Info("Migrated money field", slog.String("field", "transactions.splits.amount"), slog.Int64("count", res.ModifiedCount))
+	}
+
+	return backfillTransactionCurrency(ctx, db, logger)
+}
+
+func toMinorUnits(field string) bson.D {
+	return bson.D{{Key: "$toLong", Value: bson.D{{Key: "$round", Value: bson.A{
+		bson.D{{Key: "$multiply", Value: bson.A{field, 100}}}, 0,
+	}}}}}
+}
+
+func backfillTransactionCurrency(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	missing := bson.D{{Key: "currency", Value: bson.D{{Key: "$exists", Value: false}}}}
+
+	accountIDs, err := db.Collection("transactions").Distinct(ctx, "account_id", missing)
+	if err != nil {
+		return fmt.Errorf("finding transactions without a currency: %w", err)
+	}
+
+	names := newExportNames(db)
+	for _, value := range accountIDs {
+		accountID, ok := value.(string)
+		if !ok {
+			continue
+		}
+		account, err := names.account(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account.Currency == "" {
+			continue
+		}
+
+		filter := append(bson.D{{Key: "account_id", Value: accountID}}, missing...)
+		update := bson.D{{Key: "$set", Value: bson.D{{Key: "currency", Value: account.Currency}}}}
+		res, err := db.Collection("transactions").UpdateMany(ctx, filter, update)
+		if err != nil {
+			return fmt.Errorf("filling in the currency of account %s: %w", accountID, err)
+		}
+		logger.Info("Filled in transaction currency", slog.String("account_id", accountID), slog.Int64("count", res.ModifiedCount))
+	}
+
+	return nil
+}
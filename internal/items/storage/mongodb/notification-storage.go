@@ -64,40 +64,67 @@ func (s *NotificationStorage) GetNotifications(ctx context.Context, req *pb.GetN
 
 	notificationCollection := s.mongodb.Collection("notifications")
 
-	filter := bson.D{
-		{Key: "user_id", Value: req.UserId},
-		{Key: "is_read", Value: false},
+	filter := bson.D{{Key: "user_id", Value: req.UserId}}
+	if !req.IncludeRead {
+		filter = append(filter, bson.E{Key: "is_read", Value: false})
+	}
+
+	page, err := newPage("", req.SortOrder, map[string]string{
+		"created_at": "created_at",
+	}, "created_at", req.PageSize, req.PageToken)
+	if err != nil {
+		s.logger.Error("Invalid page request", slog.Any("error", err))
+		return nil, err
 	}
 
-	cursor, err := notificationCollection.Find(ctx, filter)
+	cursor, err := notificationCollection.Find(ctx, page.filter(filter), page.findOptions())
 	if err != nil {
 		s.logger.Error("Error finding notifications", slog.Any("error", err))
 		return nil, err
 	}
-	defer cursor.Close(ctx)
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		s.logger.Error("Error decoding notification", slog.Any("error", err))
+		return nil, err
+	}
+
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error("Error while building page token", slog.Any("error", err))
+		return nil, err
+	}
 
 	var notifications []*pb.NotificationResponse
-	for cursor.Next(ctx) {
-		var notification bson.M
-		if err := cursor.Decode(&notification); err != nil {
-			s.logger.Error("Error decoding notification", slog.Any("error", err))
-			return nil, err
-		}
-		notifications = append(notifications, &pb.NotificationResponse{
-			Id:        notification["_id"].(primitive.ObjectID).Hex(),
-			UserId:    notification["user_id"].(string),
-			Message:   notification["message"].(string),
-			IsRead:    notification["is_read"].(bool),
-			CreatedAt: notification["created_at"].(primitive.DateTime).Time().String(),
-		})
+	for _, notification := range docs {
+		notifications = append(notifications, notificationResponse(notification))
+	}
+
+	return &pb.NotificationsResponse{Notifications: notifications, NextPageToken: nextPageToken}, nil
+}
+
+func (s *NotificationStorage) GetNotificationById(ctx context.Context, id string) (*pb.NotificationResponse, error) {
+	s.logger.Info("GetNotificationById", slog.String("id", id))
+
+	notificationCollection := s.mongodb.Collection("notifications")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("Cursor error", slog.Any("error", err))
+	var notification bson.M
+	err = notificationCollection.FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&notification)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		s.logger.Error("Error fetching notification", slog.Any("error", err))
 		return nil, err
 	}
 
-	return &pb.NotificationsResponse{Notifications: notifications}, nil
+	return notificationResponse(notification), nil
 }
 
 func (s *NotificationStorage) MarkNotificationAsRead(ctx context.Context, req *pb.MarkNotificationAsReadRequest) (*pb.Empty, error) {
@@ -121,3 +148,13 @@ func (s *NotificationStorage) MarkNotificationAsRead(ctx context.Context, req *p
 
 	return &pb.Empty{}, nil
 }
+
+func notificationResponse(notification bson.M) *pb.NotificationResponse {
+	return &pb.NotificationResponse{
+		Id:        notification["_id"].(primitive.ObjectID).Hex(),
+		UserId:    notification["user_id"].(string),
+		Message:   notification["message"].(string),
+		IsRead:    notification["is_read"].(bool),
+		CreatedAt: notification["created_at"].(primitive.DateTime).Time().String(),
+	}
+}
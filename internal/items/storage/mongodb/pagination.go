@@ -0,0 +1,137 @@
+package mongodb
+
+import (
+	"encoding/base64"
+
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+var errInvalidPageToken = validation.Field("page_token", "is not a token from this list")
+
+// page is one page of a keyset-paginated list. Documents are ordered by a
+// sort field with _id as the tie-breaker, and the page token carries the
+// values of the last document returned, so pages stay stable while new
+// documents are inserted.
+type page struct {
+	field      string
+	descending bool
+	size       int
+	after      *pageCursor
+}
+
+type pageCursor struct {
+	Field string             `bson:"f"`
+	Value interface{}        `bson:"v"`
+	ID    primitive.ObjectID `bson:"id"`
+}
+
+// newPage validates the sort and page parameters of a list request. sortFields
+// maps the names clients may pass in sort_by to document fields; an empty
+// sortBy falls back to defaultSort, newest first.
+func newPage(sortBy, sortOrder string, sortFields map[string]string, defaultSort string, pageSize int32, pageToken string) (*page, error) {
+	if sortBy == "" {
+		sortBy = defaultSort
+	}
+	field, ok := sortFields[sortBy]
+	if !ok {
+		return nil, validation.Field("sort_by", "cannot sort by %q", sortBy)
+	}
+
+	p := &page{field: field, size: defaultPageSize}
+
+	switch sortOrder {
+	case "", "desc":
+		p.descending = true
+	case "asc":
+	default:
+		return nil, validation.Field("sort_order", "must be asc or desc, got %q", sortOrder)
+	}
+
+	if pageSize > 0 {
+		p.size = int(pageSize)
+	}
+	if p.size > maxPageSize {
+		p.size = maxPageSize
+	}
+
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		var cursor pageCursor
+		if err := bson.Unmarshal(raw, &cursor); err != nil || cursor.Field != field {
+			return nil, errInvalidPageToken
+		}
+		p.after = &cursor
+	}
+
+	return p, nil
+}
+
+// filter narrows filter down to the documents that come after the page token.
+func (p *page) filter(filter bson.D) bson.D {
+	if p.after == nil {
+		return filter
+	}
+
+	op := "$gt"
+	if p.descending {
+		op = "$lt"
+	}
+
+	return append(filter, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: p.field, Value: bson.D{{Key: op, Value: p.after.Value}}}},
+		bson.D{
+			{Key: p.field, Value: p.after.Value},
+			{Key: "_id", Value: bson.D{{Key: op, Value: p.after.ID}}},
+		},
+	}})
+}
+
+// findOptions sorts the query and fetches one document more than the page
+// size, which tells nextToken whether another page exists.
+func (p *page) findOptions() *options.FindOptions {
+	direction := 1
+	if p.descending {
+		direction = -1
+	}
+
+	return options.Find().
+		SetSort(bson.D{
+			{Key: p.field, Value: direction},
+			{Key: "_id", Value: direction},
+		}).
+		SetLimit(int64(p.size + 1))
+}
+
+// trim drops the extra document fetched by findOptions and returns the token
+// for the next page, or an empty token on the last page.
+func (p *page) trim(docs []bson.M) ([]bson.M, string, error) {
+	if len(docs) <= p.size {
+		return docs, "", nil
+	}
+
+	docs = docs[:p.size]
+	last := docs[len(docs)-1]
+
+	raw, err := bson.Marshal(pageCursor{
+		Field: p.field,
+		Value: last[p.field],
+		ID:    last["_id"].(primitive.ObjectID),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return docs, base64.RawURLEncoding.EncodeToString(raw), nil
+}
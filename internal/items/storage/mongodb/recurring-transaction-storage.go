@@ -0,0 +1,468 @@
+package mongodb
+
+import (
+	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/money"
+	"budgeting-service/internal/items/recurrence"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/validation"
+	"context"
+	"time"
+
+	pb "budgeting-service/genproto/transaction"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"log/slog"
+)
+
+type RecurringTransactionStorage struct {
+	mongodb *mongo.Database
+	cfg     *config.Config
+	logger  *slog.Logger
+}
+
+func NewRecurringTransactionStorage(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) repository.RecurringTransactionI {
+	return &RecurringTransactionStorage{
+		mongodb: mongodb,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+func (s *RecurringTransactionStorage) CreateRecurringTransaction(ctx context.Context, req *pb.CreateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("CreateRecurringTransaction", slog.Any("req", req))
+
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+	created_at := time.Now()
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		s.logger.Error("Error parsing start date", slog.Any("error", err))
+		return nil, validation.Field("start_date", "%q is not a date in the form YYYY-MM-DD", req.StartDate)
+	}
+
+	var endDate interface{}
+	if req.EndDate != "" {
+		end, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			s.logger.Error("Error parsing end date", slog.Any("error", err))
+			return nil, validation.Field("end_date", "%q is not a date in the form YYYY-MM-DD", req.EndDate)
+		}
+		if end.Before(startDate) {
+			return nil, validation.Field("end_date", "must not be before start_date")
+		}
+		endDate = end
+	}
+
+	amount, err := money.FromProto(req.Amount)
+	if err != nil {
+		s.logger.Error("Invalid amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+
+	rule := recurrence.Rule{
+		Frequency:  req.Frequency,
+		Interval:   int(req.Interval),
+		DayOfMonth: int(req.DayOfMonth),
+		Start:      startDate,
+	}
+	if err := rule.Validate(); err != nil {
+		s.logger.Error("Invalid schedule", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := s.checkReferences(ctx, req.UserId, req.AccountId, req.CategoryId, req.Type); err != nil {
+		s.logger.Error("Invalid recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	recurringDoc := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "account_id", Value: req.AccountId},
+		{Key: "category_id", Value: req.CategoryId},
+		{Key: "amount", Value: amount},
+		{Key: "currency", Value: req.Amount.GetCurrencyCode()},
+		{Key: "type", Value: req.Type},
+		{Key: "description", Value: req.Description},
+		{Key: "frequency", Value: req.Frequency},
+		{Key: "interval", Value: req.Interval},
+		{Key: "day_of_month", Value: req.DayOfMonth},
+		{Key: "start_date", Value: startDate},
+		{Key: "end_date", Value: endDate},
+		{Key: "next_run_date", Value: rule.First()},
+		{Key: "last_run_date", Value: nil},
+		{Key: "created_at", Value: created_at},
+		{Key: "updated_at", Value: created_at},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	res, err := recurringCollection.InsertOne(ctx, recurringDoc)
+	if err != nil {
+		s.logger.Error("Error while creating recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	return s.GetRecurringTransactionById(ctx, &pb.GetRecurringTransactionByIdRequest{Id: res.InsertedID.(primitive.ObjectID).Hex()})
+}
+
+func (s *RecurringTransactionStorage) GetRecurringTransactions(ctx context.Context, req *pb.GetRecurringTransactionsRequest) (*pb.RecurringTransactionsResponse, error) {
+	s.logger.Info("GetRecurringTransactions", slog.Any("req", req))
+
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	filter := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+
+	cursor, err := recurringCollection.Find(ctx, filter)
+	if err != nil {
+		s.logger.Error("Error while fetching recurring transactions", slog.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var recurringTransactions []*pb.RecurringTransactionResponse
+	for cursor.Next(ctx) {
+		var recurring bson.M
+		if err := cursor.Decode(&recurring); err != nil {
+			s.logger.Error("Error while decoding recurring transaction", slog.Any("error", err))
+			return nil, err
+		}
+
+		recurringTransactions = append(recurringTransactions, recurringTransactionResponse(recurring))
+	}
+
+	if err := cursor.Err(); err != nil {
+		s.logger.Error("Cursor error", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &pb.RecurringTransactionsResponse{RecurringTransactions: recurringTransactions}, nil
+}
+
+func (s *RecurringTransactionStorage) GetRecurringTransactionById(ctx context.Context, req *pb.GetRecurringTransactionByIdRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("GetRecurringTransactionById", slog.String("id", req.Id))
+
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "_id", Value: objID}}
+
+	var recurring bson.M
+	err = recurringCollection.FindOne(ctx, filter).Decode(&recurring)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			s.logger.Info("Recurring transaction not found", slog.String("id", req.Id))
+			return nil, nil
+		}
+		s.logger.Error("Error finding recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	return recurringTransactionResponse(recurring), nil
+}
+
+func (s *RecurringTransactionStorage) UpdateRecurringTransaction(ctx context.Context, req *pb.UpdateRecurringTransactionRequest) (*pb.RecurringTransactionResponse, error) {
+	s.logger.Info("UpdateRecurringTransaction", slog.Any("req", req))
+
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+
+	var current struct {
+		UserID      string     `bson:"user_id"`
+		Type        string     `bson:"type"`
+		Frequency   string     `bson:"frequency"`
+		Interval    int        `bson:"interval"`
+		DayOfMonth  int        `bson:"day_of_month"`
+		StartDate   time.Time  `bson:"start_date"`
+		LastRunDate *time.Time `bson:"last_run_date"`
+	}
+	err = recurringCollection.FindOne(ctx, filter).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			s.logger.Info("Recurring transaction not found", slog.String("id", req.Id))
+			return nil, nil
+		}
+		s.logger.Error("Error finding recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	if req.AccountId != "" || req.CategoryId != "" {
+		if err := s.checkReferences(ctx, current.UserID, req.AccountId, req.CategoryId, current.Type); err != nil {
+			s.logger.Error("Invalid recurring transaction", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	updateFields := bson.D{}
+	if req.AccountId != "" {
+		updateFields = append(updateFields, bson.E{Key: "account_id", Value: req.AccountId})
+	}
+	if req.CategoryId != "" {
+		updateFields = append(updateFields, bson.E{Key: "category_id", Value: req.CategoryId})
+	}
+	if req.Amount != nil {
+		amount, err := money.FromProto(req.Amount)
+		if err != nil {
+			s.logger.Error("Invalid amount", slog.Any("error", err))
+			return nil, validation.Field("amount", "%v", err)
+		}
+		updateFields = append(updateFields, bson.E{Key: "amount", Value: amount})
+		if req.Amount.CurrencyCode != "" {
+			updateFields = append(updateFields, bson.E{Key: "currency", Value: req.Amount.CurrencyCode})
+		}
+	}
+	if req.Description != "" {
+		updateFields = append(updateFields, bson.E{Key: "description", Value: req.Description})
+	}
+	if req.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			s.logger.Error("Error parsing end date", slog.Any("error", err))
+			return nil, validation.Field("end_date", "%q is not a date in the form YYYY-MM-DD", req.EndDate)
+		}
+		if endDate.Before(current.StartDate) {
+			return nil, validation.Field("end_date", "must not be before start_date")
+		}
+		updateFields = append(updateFields, bson.E{Key: "end_date", Value: endDate})
+	}
+
+	if req.Frequency != "" || req.Interval != 0 || req.DayOfMonth != 0 {
+		rule := recurrence.Rule{
+			Frequency:  current.Frequency,
+			Interval:   current.Interval,
+			DayOfMonth: current.DayOfMonth,
+			Start:      current.StartDate,
+		}
+		if req.Frequency != "" {
+			rule.Frequency = req.Frequency
+		}
+		if req.Interval != 0 {
+			rule.Interval = int(req.Interval)
+		}
+		if req.DayOfMonth != 0 {
+			rule.DayOfMonth = int(req.DayOfMonth)
+		}
+		if err := rule.Validate(); err != nil {
+			s.logger.Error("Invalid schedule", slog.Any("error", err))
+			return nil, err
+		}
+
+		nextRun := rule.First()
+		if current.LastRunDate != nil {
+			nextRun = rule.Next(*current.LastRunDate)
+		}
+
+		updateFields = append(updateFields,
+			bson.E{Key: "frequency", Value: rule.Frequency},
+			bson.E{Key: "interval", Value: int32(rule.Interval)},
+			bson.E{Key: "day_of_month", Value: int32(rule.DayOfMonth)},
+			bson.E{Key: "next_run_date", Value: nextRun},
+		)
+	}
+
+	if len(updateFields) == 0 {
+		s.logger.Info("No fields to update")
+		return nil, nil
+	}
+	updateFields = append(updateFields, bson.E{Key: "updated_at", Value: time.Now()})
+
+	update := bson.D{{Key: "$set", Value: updateFields}}
+
+	res := recurringCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if res.Err() != nil {
+		if res.Err() == mongo.ErrNoDocuments {
+			s.logger.Info("Recurring transaction not found", slog.String("id", req.Id))
+			return nil, nil
+		}
+		s.logger.Error("Error updating recurring transaction", slog.Any("error", res.Err()))
+		return nil, res.Err()
+	}
+
+	var updatedRecurring bson.M
+	if err = res.Decode(&updatedRecurring); err != nil {
+		s.logger.Error("Error decoding updated recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	return recurringTransactionResponse(updatedRecurring), nil
+}
+
+func (s *RecurringTransactionStorage) DeleteRecurringTransaction(ctx context.Context, req *pb.DeleteRecurringTransactionRequest) (*pb.Empty, error) {
+	s.logger.Info("DeleteRecurringTransaction", slog.String("id", req.Id))
+
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "_id", Value: objID}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "deleted_at", Value: time.Now()},
+		}},
+	}
+
+	_, err = recurringCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		s.logger.Error("Error deleting recurring transaction", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *RecurringTransactionStorage) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]*pb.RecurringTransactionResponse, error) {
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	filter := bson.D{
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "next_run_date", Value: bson.D{{Key: "$lte", Value: now}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "end_date", Value: nil}},
+			bson.D{{Key: "$expr", Value: bson.D{{Key: "$lte", Value: bson.A{"$next_run_date", "$end_date"}}}}},
+		}},
+	}
+
+	cursor, err := recurringCollection.Find(ctx, filter)
+	if err != nil {
+		s.logger.Error("Error while fetching due recurring transactions", slog.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var due []*pb.RecurringTransactionResponse
+	for cursor.Next(ctx) {
+		var recurring bson.M
+		if err := cursor.Decode(&recurring); err != nil {
+			s.logger.Error("Error while decoding recurring transaction", slog.Any("error", err))
+			return nil, err
+		}
+
+		due = append(due, recurringTransactionResponse(recurring))
+	}
+
+	if err := cursor.Err(); err != nil {
+		s.logger.Error("Cursor error", slog.Any("error", err))
+		return nil, err
+	}
+
+	return due, nil
+}
+
+// AdvanceRecurringTransaction moves next_run_date from occurrence to next. It
+// only succeeds if next_run_date still equals occurrence, so two schedulers
+// can never both claim the same occurrence.
+func (s *RecurringTransactionStorage) AdvanceRecurringTransaction(ctx context.Context, id string, occurrence, next time.Time) (bool, error) {
+	recurringCollection := s.mongodb.Collection("recurring_transactions")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "next_run_date", Value: occurrence},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "next_run_date", Value: next},
+			{Key: "last_run_date", Value: occurrence},
+		}},
+	}
+
+	res, err := recurringCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		s.logger.Error("Error advancing recurring transaction", slog.Any("error", err))
+		return false, err
+	}
+
+	return res.ModifiedCount == 1, nil
+}
+
+// EnsureIndexes makes every generated occurrence unique per recurring
+// transaction and date, so a retried occurrence is rejected as a duplicate.
+func (s *RecurringTransactionStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("transactions").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "recurring_id", Value: 1},
+			{Key: "date", Value: 1},
+		},
+		Options: options.Index().
+			SetName("recurring_occurrence").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "recurring_id", Value: bson.D{{Key: "$type", Value: "string"}}}}),
+	})
+	if err != nil {
+		s.logger.Error("Error creating recurring occurrence index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// checkReferences checks that the account and category a recurring
+// transaction posts to, where given, are the user's own and that the category
+// takes transactions of its type.
+func (s *RecurringTransactionStorage) checkReferences(ctx context.Context, userID, accountID, categoryID, transactionType string) error {
+	if accountID != "" {
+		if _, err := accountCurrency(ctx, s.mongodb, userID, accountID, "account_id"); err != nil {
+			return err
+		}
+	}
+	if categoryID != "" {
+		return checkCategory(ctx, s.mongodb, userID, categoryID, transactionType, "category_id")
+	}
+	return nil
+}
+
+func recurringTransactionResponse(recurring bson.M) *pb.RecurringTransactionResponse {
+	response := &pb.RecurringTransactionResponse{
+		Id:          recurring["_id"].(primitive.ObjectID).Hex(),
+		UserId:      recurring["user_id"].(string),
+		AccountId:   recurring["account_id"].(string),
+		CategoryId:  recurring["category_id"].(string),
+		Amount:      money.ToProto(storedAmount(recurring["amount"]), storedString(recurring["currency"])),
+		Type:        recurring["type"].(string),
+		Description: recurring["description"].(string),
+		Frequency:   recurring["frequency"].(string),
+		Interval:    recurring["interval"].(int32),
+		DayOfMonth:  recurring["day_of_month"].(int32),
+		StartDate:   recurring["start_date"].(primitive.DateTime).Time().UTC().Format("2006-01-02"),
+		NextRunDate: recurring["next_run_date"].(primitive.DateTime).Time().UTC().Format("2006-01-02"),
+		CreatedAt:   recurring["created_at"].(primitive.DateTime).Time().String(),
+		UpdatedAt:   recurring["updated_at"].(primitive.DateTime).Time().String(),
+	}
+	if endDate, ok := recurring["end_date"].(primitive.DateTime); ok {
+		response.EndDate = endDate.Time().UTC().Format("2006-01-02")
+	}
+	if lastRunDate, ok := recurring["last_run_date"].(primitive.DateTime); ok {
+		response.LastRunDate = lastRunDate.Time().UTC().Format("2006-01-02")
+	}
+
+	return response
+}
@@ -1,8 +1,13 @@
 package mongodb
 
 import (
+	common_pb "budgeting-service/genproto/common"
 	pb "budgeting-service/genproto/report"
+	"budgeting-service/internal/items/budgetperiod"
+	"budgeting-service/internal/items/categorytree"
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/money"
 	"budgeting-service/internal/items/repository"
 	"context"
 	"time"
@@ -32,9 +37,6 @@ func NewReportStorage(mongodb *mongo.Database, cfg *config.Config, logger *slog.
 func (s *ReportStorage) GetSpendingReport(ctx context.Context, req *pb.GetSpendingReportRequest) (*pb.SpendingReportResponse, error) {
 	s.logger.Info("GetSpendingReport")
 
-	transactionCollection := s.mongodb.Collection("transactions")
-	categoryCollection := s.mongodb.Collection("categories")
-
 	startDate, err := time.Parse("2006-01-02", req.StartDate)
 	if err != nil {
 		s.logger.Error("error while parsing start date:", slog.String("err", err.Error()))
@@ -56,74 +58,31 @@ func (s *ReportStorage) GetSpendingReport(ctx context.Context, req *pb.GetSpendi
 		"type": "expense",
 	}
 
-	projection := bson.M{
-		"category_id": 1,
-		"amount":      1,
-		"_id":         0,
-	}
-
-	var totalSpending float32
-	categorySpending := make(map[string]float32)
-
-	cursor, err := transactionCollection.Find(ctx, filter, options.Find().SetProjection(projection))
+	converter, err := s.converter(ctx, req.UserId, req.Currency)
 	if err != nil {
-		s.logger.Error("error while querying transactions:", slog.String("err", err.Error()))
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	for cursor.Next(ctx) {
-		var transaction struct {
-			CategoryID primitive.ObjectID `bson:"category_id"`
-			Amount     float64            `bson:"amount"`
-		}
-		if err := cursor.Decode(&transaction); err != nil {
-			s.logger.Error("error while decoding transaction:", slog.String("err", err.Error()))
-			return nil, err
-		}
-
-		filter := bson.M{"_id": transaction.CategoryID}
-		projection := bson.M{"name": 1, "_id": 0}
 
-		var categoryName struct {
-			Name string `bson:"name"`
-		}
-
-		err = categoryCollection.FindOne(ctx, filter, options.FindOne().SetProjection(projection)).Decode(&categoryName)
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				s.logger.Error("Category not found", slog.String("category_id", transaction.CategoryID.Hex()))
-			} else {
-				s.logger.Error("error while querying category:", slog.String("err", err.Error()))
-				return nil, err
-			}
-		}
-
-		if _, exists := categorySpending[categoryName.Name]; !exists {
-			categorySpending[categoryName.Name] = 0
-		}
-		categorySpending[categoryName.Name] += float32(transaction.Amount)
-
-		totalSpending += float32(transaction.Amount)
+	tree, err := s.rollUp(ctx, req.UserId, req.RollUp)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("error while iterating cursor:", slog.String("err", err.Error()))
+	totals, err := s.sumByCategory(ctx, filter, converter, tree)
+	if err != nil {
 		return nil, err
 	}
 
 	return &pb.SpendingReportResponse{
-		TotalSpending:    totalSpending,
-		CategorySpending: categorySpending,
+		TotalSpending:    totals.totalMoney(),
+		CategorySpending: totals.categoryMoney(),
+		Currency:         totals.currency,
 	}, nil
 }
 
 func (s *ReportStorage) GetIncomeReport(ctx context.Context, req *pb.GetIncomeReportRequest) (*pb.IncomeReportResponse, error) {
 	s.logger.Info("GetIncomeReport")
 
-	transactionCollection := s.mongodb.Collection("transactions")
-	categoryCollection := s.mongodb.Collection("categories")
-
 	startDate, err := time.Parse("2006-01-02", req.StartDate)
 	if err != nil {
 		s.logger.Error("error while parsing start date:", slog.String("err", err.Error()))
@@ -145,91 +104,57 @@ func (s *ReportStorage) GetIncomeReport(ctx context.Context, req *pb.GetIncomeRe
 		"type": "income",
 	}
 
-	projection := bson.M{
-		"category_id": 1,
-		"amount":      1,
-		"_id":         0,
-	}
-
-	var totalIncoming float32
-	categoryIncoming := make(map[string]float32)
-
-	cursor, err := transactionCollection.Find(ctx, filter, options.Find().SetProjection(projection))
+	converter, err := s.converter(ctx, req.UserId, req.Currency)
 	if err != nil {
-		s.logger.Error("error while querying transactions:", slog.String("err", err.Error()))
 		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	for cursor.Next(ctx) {
-		var transaction struct {
-			CategoryID primitive.ObjectID `bson:"category_id"`
-			Amount     float64            `bson:"amount"`
-		}
-		if err := cursor.Decode(&transaction); err != nil {
-			s.logger.Error("error while decoding transaction:", slog.String("err", err.Error()))
-			return nil, err
-		}
-
-		filter := bson.M{"_id": transaction.CategoryID}
-		projection := bson.M{"name": 1, "_id": 0}
-
-		var categoryName struct {
-			Name string `bson:"name"`
-		}
-
-		err = categoryCollection.FindOne(ctx, filter, options.FindOne().SetProjection(projection)).Decode(&categoryName)
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				s.logger.Error("Category not found", slog.String("category_id", transaction.CategoryID.Hex()))
-			} else {
-				s.logger.Error("error while querying category:", slog.String("err", err.Error()))
-				return nil, err
-			}
-		}
-
-		if _, exists := categoryIncoming[categoryName.Name]; !exists {
-			categoryIncoming[categoryName.Name] = 0
-		}
-		categoryIncoming[categoryName.Name] += float32(transaction.Amount)
 
-		totalIncoming += float32(transaction.Amount)
+	tree, err := s.rollUp(ctx, req.UserId, req.RollUp)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("error while iterating cursor:", slog.String("err", err.Error()))
+	totals, err := s.sumByCategory(ctx, filter, converter, tree)
+	if err != nil {
 		return nil, err
 	}
 
 	return &pb.IncomeReportResponse{
-		TotalIncome:    totalIncoming,
-		CategoryIncome: categoryIncoming,
+		TotalIncome:    totals.totalMoney(),
+		CategoryIncome: totals.categoryMoney(),
+		Currency:       totals.currency,
 	}, nil
 }
 
+// GetBudgetPerformanceReport measures a budget against the spending in the
+// categories it covers over the budget's current period.
 func (s *ReportStorage) GetBudgetPerformanceReport(ctx context.Context, req *pb.GetBudgetPerformanceReportRequest) (*pb.BudgetPerformanceReportResponse, error) {
 	s.logger.Info("GetBudgetPerformanceReport")
 
-	transactionCollection := s.mongodb.Collection("transactions")
 	budgetCollection := s.mongodb.Collection("budgets")
-	categoryCollection := s.mongodb.Collection("categories")
 
 	budgetId, err := primitive.ObjectIDFromHex(req.BudgetId)
 	if err != nil {
 		return nil, err
 	}
 
-	filter1 := bson.D{{Key: "_id", Value: budgetId}}
+	filter1 := bson.D{{Key: "_id", Value: budgetId}, {Key: "user_id", Value: req.UserId}}
 	projection1 := bson.D{
+		{Key: "category_id", Value: 1},
 		{Key: "start_date", Value: 1},
 		{Key: "end_date", Value: 1},
 		{Key: "amount", Value: 1},
+		{Key: "carry_over", Value: 1},
+		{Key: "currency", Value: 1},
 	}
 
 	var budget struct {
-		StartDate time.Time `bson:"start_date"`
-		EndDate   time.Time `bson:"end_date"`
-		Amount    float64   `bson:"amount"`
+		CategoryID string    `bson:"category_id"`
+		StartDate  time.Time `bson:"start_date"`
+		EndDate    time.Time `bson:"end_date"`
+		Amount     int64     `bson:"amount"`
+		CarryOver  int64     `bson:"carry_over"`
+		Currency   string    `bson:"currency"`
 	}
 
 	err = budgetCollection.FindOne(ctx, filter1, options.FindOne().SetProjection(projection1)).Decode(&budget)
@@ -238,130 +163,185 @@ func (s *ReportStorage) GetBudgetPerformanceReport(ctx context.Context, req *pb.
 		return nil, err
 	}
 
-	filter2 := bson.M{
-		"user_id": req.UserId,
-		"date": bson.M{
-			"$gte": budget.StartDate,
-			"$lte": budget.EndDate,
-		},
-		"type": "expense",
+	categoryIDs, err := budgetCategories(ctx, s.mongodb, budget.CategoryID)
+	if err != nil {
+		s.logger.Error("error while querying budget categories:", slog.String("err", err.Error()))
+		return nil, err
 	}
 
-	projection2 := bson.M{
-		"category_id": 1,
-		"amount":      1,
-		"_id":         0,
+	filter2 := bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "type", Value: "expense"},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: budget.StartDate}, {Key: "$lte", Value: budget.EndDate}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		categoriesFilter(categoryIDs),
 	}
 
-	var totalSpending float32
-	categorySpending := make(map[string]float32)
-
-	cursor, err := transactionCollection.Find(ctx, filter2, options.Find().SetProjection(projection2))
+	converter, err := s.converter(ctx, req.UserId, req.Currency)
 	if err != nil {
-		s.logger.Error("error while querying transactions:", slog.String("err", err.Error()))
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	for cursor.Next(ctx) {
-		var transaction struct {
-			CategoryID primitive.ObjectID `bson:"category_id"`
-			Amount     float64            `bson:"amount"`
-		}
-		if err := cursor.Decode(&transaction); err != nil {
-			s.logger.Error("error while decoding transaction:", slog.String("err", err.Error()))
-			return nil, err
-		}
-
-		filter := bson.M{"_id": transaction.CategoryID}
-		projection := bson.M{"name": 1, "_id": 0}
+	totalBudget, err := converter.Convert(ctx, budget.Amount+budget.CarryOver, budget.Currency, budget.StartDate)
+	if err != nil {
+		s.logger.Error("error while converting budget amount:", slog.String("err", err.Error()))
+		return nil, err
+	}
 
-		var categoryName struct {
-			Name string `bson:"name"`
-		}
+	totals := &categoryTotals{categories: make(map[string]int64), currency: converter.Currency()}
+	spentByCategory := make(map[string]int64)
+	spentByDay := make(map[string]int64)
+	err = eachAmount(ctx, s.mongodb, filter2, categoryIDs, converter, func(categoryID string, date time.Time, amount int64) {
+		totals.total += amount
+		spentByCategory[categoryID] += amount
+		spentByDay[date.UTC().Format("2006-01-02")] += amount
+	})
+	if err != nil {
+		s.logger.Error("error while summing budget spending:", slog.String("err", err.Error()))
+		return nil, err
+	}
 
-		err = categoryCollection.FindOne(ctx, filter, options.FindOne().SetProjection(projection)).Decode(&categoryName)
+	for categoryID, amount := range spentByCategory {
+		name, err := s.categoryName(ctx, categoryID)
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				s.logger.Error("Category not found", slog.String("category_id", transaction.CategoryID.Hex()))
-			} else {
-				s.logger.Error("error while querying category:", slog.String("err", err.Error()))
-				return nil, err
-			}
-		}
-
-		if _, exists := categorySpending[categoryName.Name]; !exists {
-			categorySpending[categoryName.Name] = 0
+			return nil, err
 		}
-		categorySpending[categoryName.Name] += float32(transaction.Amount)
-
-		totalSpending += float32(transaction.Amount)
+		totals.categories[name] += amount
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("error while iterating cursor:", slog.String("err", err.Error()))
-		return nil, err
+	pace := budgetperiod.Measure(totalBudget, totals.total, budget.StartDate, budget.EndDate, time.Now().UTC())
+
+	var daily []*pb.DailySpending
+	var cumulative int64
+	for i := 0; i < pace.DaysElapsed; i++ {
+		date := budget.StartDate.AddDate(0, 0, i).UTC().Format("2006-01-02")
+		cumulative += spentByDay[date]
+		daily = append(daily, &pb.DailySpending{
+			Date:       date,
+			Spent:      money.ToProto(spentByDay[date], totals.currency),
+			Cumulative: money.ToProto(cumulative, totals.currency),
+		})
 	}
 
 	return &pb.BudgetPerformanceReportResponse{
-		TotalBudget:         float32(budget.Amount),
-		TotalSpent:          totalSpending,
-		CategoryPerformance: categorySpending,
+		TotalBudget:         money.ToProto(totalBudget, totals.currency),
+		TotalSpent:          totals.totalMoney(),
+		CategoryPerformance: totals.categoryMoney(),
+		Currency:            totals.currency,
+		Remaining:           money.ToProto(pace.Remaining, totals.currency),
+		PercentUsed:         pace.PercentUsed,
+		DailyBurnRate:       money.ToProto(pace.DailyBurn, totals.currency),
+		ProjectedSpend:      money.ToProto(pace.Projected, totals.currency),
+		DaysElapsed:         int32(pace.DaysElapsed),
+		DaysTotal:           int32(pace.DaysTotal),
+		DailySpending:       daily,
 	}, nil
 }
 
 func (s *ReportStorage) GetGoalProgressReport(ctx context.Context, req *pb.GetGoalProgressReportRequest) (*pb.GoalProgressReportResponse, error) {
 	s.logger.Info("GetGoalProgressReport")
 
-	transactionCollection := s.mongodb.Collection("transactions")
 	goalCollection := s.mongodb.Collection("goals")
-	categoryCollection := s.mongodb.Collection("categories")
 
 	goalId, err := primitive.ObjectIDFromHex(req.GoalId)
 	if err != nil {
 		return nil, err
 	}
 
-	filter1 := bson.D{{Key: "_id", Value: goalId}}
-	projection1 := bson.D{
-		{Key: "created_at", Value: 1},
-		{Key: "deadline", Value: 1},
-		{Key: "target_amount", Value: 1},
-		{Key: "current_amount", Value: 1},
+	var doc bson.M
+	err = goalCollection.FindOne(ctx, bson.D{{Key: "_id", Value: goalId}, {Key: "user_id", Value: req.UserId}}).Decode(&doc)
+	if err != nil {
+		s.logger.Error("error while querying goal:", slog.String("err", err.Error()))
+		return nil, err
 	}
+	goal := newProgressGoal(doc)
 
-	var goal struct {
-		CreatedAt     time.Time `bson:"created_at"`
-		Deadline      time.Time `bson:"deadline"`
-		TargetAmount  float64   `bson:"target_amount"`
-		CurrentAmount float64   `bson:"current_amount"`
+	converter, err := s.converter(ctx, req.UserId, req.Currency)
+	if err != nil {
+		return nil, err
 	}
 
-	err = goalCollection.FindOne(ctx, filter1, options.FindOne().SetProjection(projection1)).Decode(&goal)
+	now := time.Now()
+	progress, sources, err := goalProgress(ctx, s.mongodb, goal, converter, now)
 	if err != nil {
-		s.logger.Error("error while querying budget:", slog.String("err", err.Error()))
+		s.logger.Error("error while computing goal progress:", slog.String("err", err.Error()))
 		return nil, err
 	}
 
-	filter2 := bson.M{
-		"user_id": req.UserId,
-		"date": bson.M{
-			"$gte": goal.CreatedAt,
-			"$lte": goal.Deadline,
-		},
-		"type": "income",
+	target, err := converter.Convert(ctx, goal.TargetAmount, goal.Currency, now)
+	if err != nil {
+		s.logger.Error("error while converting goal amount:", slog.String("err", err.Error()))
+		return nil, err
 	}
 
-	projection2 := bson.M{
+	totals := &categoryTotals{total: progress, categories: sources, currency: converter.Currency()}
+
+	return &pb.GoalProgressReportResponse{
+		TotalProgress:       totals.totalMoney(),
+		TargetAmount:        money.ToProto(target-progress, totals.currency),
+		CategoryPerformance: totals.categoryMoney(),
+		Currency:            totals.currency,
+	}, nil
+}
+
+// converter converts report amounts into the requested currency, or into the
+// user's base currency when the request names none.
+func (s *ReportStorage) converter(ctx context.Context, userID, currency string) (*exchange.Converter, error) {
+	if currency == "" {
+		var err error
+		currency, err = baseCurrency(ctx, s.mongodb, s.cfg, userID)
+		if err != nil {
+			s.logger.Error("error while querying base currency:", slog.String("err", err.Error()))
+			return nil, err
+		}
+	}
+
+	currency, err := exchange.Currency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange.NewConverter(currency, rateLookup(s.mongodb)), nil
+}
+
+// rollUp returns the user's category tree when subcategory totals are to be
+// rolled up into their top level category, and nil otherwise.
+func (s *ReportStorage) rollUp(ctx context.Context, userID string, rollUp bool) (*categorytree.Tree, error) {
+	if !rollUp {
+		return nil, nil
+	}
+
+	tree, err := userCategoryTree(ctx, s.mongodb, userID)
+	if err != nil {
+		s.logger.Error("error while querying categories:", slog.String("err", err.Error()))
+		return nil, err
+	}
+	return tree, nil
+}
+
+// sumByCategory adds up the amounts of every live transaction matching filter,
+// both in total and per category name. A transaction with splits is counted
+// under each split's own category rather than under its top-level category.
+// Each amount is converted at the rate for its transaction's date. Given a
+// tree, subcategories are counted under their top level category.
+func (s *ReportStorage) sumByCategory(ctx context.Context, filter bson.M, converter *exchange.Converter, tree *categorytree.Tree) (*categoryTotals, error) {
+	transactionCollection := s.mongodb.Collection("transactions")
+
+	filter["deleted_at"] = nil
+
+	projection := bson.M{
 		"category_id": 1,
 		"amount":      1,
+		"currency":    1,
+		"date":        1,
+		"splits":      1,
 		"_id":         0,
 	}
 
-	var totalIncoming float32
-	categoryIncoming := make(map[string]float32)
+	totals := &categoryTotals{categories: make(map[string]int64), currency: converter.Currency()}
+	categoryNames := make(map[string]string)
 
-	cursor, err := transactionCollection.Find(ctx, filter2, options.Find().SetProjection(projection2))
+	cursor, err := transactionCollection.Find(ctx, filter, options.Find().SetProjection(projection))
 	if err != nil {
 		s.logger.Error("error while querying transactions:", slog.String("err", err.Error()))
 		return nil, err
@@ -369,38 +349,36 @@ func (s *ReportStorage) GetGoalProgressReport(ctx context.Context, req *pb.GetGo
 	defer cursor.Close(ctx)
 
 	for cursor.Next(ctx) {
-		var transaction struct {
-			CategoryID primitive.ObjectID `bson:"category_id"`
-			Amount     float64            `bson:"amount"`
-		}
+		var transaction reportTransaction
 		if err := cursor.Decode(&transaction); err != nil {
 			s.logger.Error("error while decoding transaction:", slog.String("err", err.Error()))
 			return nil, err
 		}
 
-		filter := bson.M{"_id": transaction.CategoryID}
-		projection := bson.M{"name": 1, "_id": 0}
+		for _, part := range transaction.categoryAmounts() {
+			categoryID := part.CategoryID
+			if tree != nil {
+				categoryID = tree.Root(categoryID)
+			}
 
-		var categoryName struct {
-			Name string `bson:"name"`
-		}
+			name, ok := categoryNames[categoryID]
+			if !ok {
+				name, err = s.categoryName(ctx, categoryID)
+				if err != nil {
+					return nil, err
+				}
+				categoryNames[categoryID] = name
+			}
 
-		err = categoryCollection.FindOne(ctx, filter, options.FindOne().SetProjection(projection)).Decode(&categoryName)
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				s.logger.Error("Category not found", slog.String("category_id", transaction.CategoryID.Hex()))
-			} else {
-				s.logger.Error("error while querying category:", slog.String("err", err.Error()))
+			amount, err := converter.Convert(ctx, part.Amount, transaction.Currency, transaction.Date)
+			if err != nil {
+				s.logger.Error("error while converting transaction amount:", slog.String("err", err.Error()))
 				return nil, err
 			}
-		}
 
-		if _, exists := categoryIncoming[categoryName.Name]; !exists {
-			categoryIncoming[categoryName.Name] = 0
+			totals.categories[name] += amount
+			totals.total += amount
 		}
-		categoryIncoming[categoryName.Name] += float32(transaction.Amount)
-
-		totalIncoming += float32(transaction.Amount)
 	}
 
 	if err := cursor.Err(); err != nil {
@@ -408,9 +386,73 @@ func (s *ReportStorage) GetGoalProgressReport(ctx context.Context, req *pb.GetGo
 		return nil, err
 	}
 
-	return &pb.GoalProgressReportResponse{
-		TotalProgress:       totalIncoming,
-		TargetAmount:        float32(goal.TargetAmount) - float32(goal.CurrentAmount),
-		CategoryPerformance: categoryIncoming,
-	}, nil
+	return totals, nil
+}
+
+// categoryTotals holds report sums in minor units of currency.
+type categoryTotals struct {
+	total      int64
+	categories map[string]int64
+	currency   string
+}
+
+func (t *categoryTotals) totalMoney() *common_pb.Money {
+	return money.ToProto(t.total, t.currency)
+}
+
+func (t *categoryTotals) categoryMoney() map[string]*common_pb.Money {
+	categories := make(map[string]*common_pb.Money, len(t.categories))
+	for name, amount := range t.categories {
+		categories[name] = money.ToProto(amount, t.currency)
+	}
+	return categories
+}
+
+func (s *ReportStorage) categoryName(ctx context.Context, categoryID string) (string, error) {
+	categoryCollection := s.mongodb.Collection("categories")
+
+	objID, err := primitive.ObjectIDFromHex(categoryID)
+	if err != nil {
+		s.logger.Error("Category not found", slog.String("category_id", categoryID))
+		return "", nil
+	}
+
+	filter := bson.M{"_id": objID}
+	projection := bson.M{"name": 1, "_id": 0}
+
+	var category struct {
+		Name string `bson:"name"`
+	}
+
+	err = categoryCollection.FindOne(ctx, filter, options.FindOne().SetProjection(projection)).Decode(&category)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			s.logger.Error("Category not found", slog.String("category_id", categoryID))
+			return "", nil
+		}
+		s.logger.Error("error while querying category:", slog.String("err", err.Error()))
+		return "", err
+	}
+
+	return category.Name, nil
+}
+
+type reportSplit struct {
+	CategoryID string `bson:"category_id"`
+	Amount     int64  `bson:"amount"`
+}
+
+type reportTransaction struct {
+	CategoryID string        `bson:"category_id"`
+	Amount     int64         `bson:"amount"`
+	Currency   string        `bson:"currency"`
+	Date       time.Time     `bson:"date"`
+	Splits     []reportSplit `bson:"splits"`
+}
+
+func (t reportTransaction) categoryAmounts() []reportSplit {
+	if len(t.Splits) > 0 {
+		return t.Splits
+	}
+	return []reportSplit{{CategoryID: t.CategoryID, Amount: t.Amount}}
 }
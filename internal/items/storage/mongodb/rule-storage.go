@@ -0,0 +1,650 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	common_pb "budgeting-service/genproto/common"
+	pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/money"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/rules"
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RuleStorage struct {
+	mongodb *mongo.Database
+	cfg     *config.Config
+	logger  *slog.Logger
+}
+
+func NewRuleStorage(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) repository.RuleI {
+	return &RuleStorage{
+		mongodb: mongodb,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+// ruleDoc is a rule as stored in the rules collection.
+type ruleDoc struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	UserID     string             `bson:"user_id"`
+	Name       string             `bson:"name"`
+	Priority   int32              `bson:"priority"`
+	Conditions ruleConditions     `bson:"conditions"`
+	Actions    ruleActions        `bson:"actions"`
+	Disabled   bool               `bson:"disabled"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+}
+
+type ruleConditions struct {
+	DescriptionContains string `bson:"description_contains"`
+	DescriptionRegex    string `bson:"description_regex"`
+	MinAmount           int64  `bson:"min_amount"`
+	MaxAmount           int64  `bson:"max_amount"`
+	Currency            string `bson:"currency"`
+	AccountID           string `bson:"account_id"`
+	Type                string `bson:"type"`
+}
+
+type ruleActions struct {
+	CategoryID     string   `bson:"category_id"`
+	AddTags        []string `bson:"add_tags"`
+	SetDescription string   `bson:"set_description"`
+}
+
+func (s *RuleStorage) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("CreateRule", slog.Any("req", req))
+
+	rule, err := s.checkRule(ctx, req.UserId, req.Conditions, req.Actions)
+	if err != nil {
+		s.logger.Error("Invalid rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	created_at := time.Now()
+	res, err := s.mongodb.Collection("rules").InsertOne(ctx, bson.D{
+		{Key: "user_id", Value: req.UserId},
+		{Key: "name", Value: strings.TrimSpace(req.Name)},
+		{Key: "priority", Value: req.Priority},
+		{Key: "conditions", Value: ruleConditions(rule.Conditions)},
+		{Key: "actions", Value: ruleActions(rule.Actions)},
+		{Key: "disabled", Value: req.Disabled},
+		{Key: "created_at", Value: created_at},
+		{Key: "updated_at", Value: created_at},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		s.logger.Error("Error while inserting rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return s.GetRuleById(ctx, &pb.GetRuleByIdRequest{Id: res.InsertedID.(primitive.ObjectID).Hex()})
+}
+
+func (s *RuleStorage) GetRules(ctx context.Context, req *pb.GetRulesRequest) (*pb.RulesResponse, error) {
+	s.logger.Info("GetRules", slog.String("user_id", req.UserId))
+
+	docs, err := findRules(ctx, s.mongodb, bson.D{{Key: "user_id", Value: req.UserId}})
+	if err != nil {
+		s.logger.Error("Error while retrieving rules", slog.Any("error", err))
+		return nil, err
+	}
+
+	response := &pb.RulesResponse{}
+	for _, doc := range docs {
+		response.Rules = append(response.Rules, ruleResponse(doc))
+	}
+	return response, nil
+}
+
+func (s *RuleStorage) GetRuleById(ctx context.Context, req *pb.GetRuleByIdRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("GetRuleById", slog.String("id", req.Id))
+
+	doc, err := s.liveRule(ctx, req.Id)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	return ruleResponse(*doc), nil
+}
+
+// UpdateRule replaces the rule's name, priority, conditions and actions as a
+// whole, the way they were given when it was created.
+func (s *RuleStorage) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.RuleResponse, error) {
+	s.logger.Info("UpdateRule", slog.Any("req", req))
+
+	current, err := s.liveRule(ctx, req.Id)
+	if err != nil || current == nil {
+		return nil, err
+	}
+
+	rule, err := s.checkRule(ctx, current.UserID, req.Conditions, req.Actions)
+	if err != nil {
+		s.logger.Error("Invalid rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: current.ID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "name", Value: strings.TrimSpace(req.Name)},
+		{Key: "priority", Value: req.Priority},
+		{Key: "conditions", Value: ruleConditions(rule.Conditions)},
+		{Key: "actions", Value: ruleActions(rule.Actions)},
+		{Key: "disabled", Value: req.Disabled},
+		{Key: "updated_at", Value: time.Now()},
+	}}}
+
+	var updated ruleDoc
+	err = s.mongodb.Collection("rules").FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Info("Rule not found", slog.String("id", req.Id))
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Error while updating rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return ruleResponse(updated), nil
+}
+
+func (s *RuleStorage) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.Empty, error) {
+	s.logger.Info("DeleteRule", slog.String("id", req.Id))
+
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	_, err = s.mongodb.Collection("rules").UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}}})
+	if err != nil {
+		s.logger.Error("Error while deleting rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// PreviewRules shows what the user's rules, or a draft rule given in the
+// request, would do to the transactions in scope, without changing any.
+func (s *RuleStorage) PreviewRules(ctx context.Context, req *pb.PreviewRulesRequest) (*pb.PreviewRulesResponse, error) {
+	s.logger.Info("PreviewRules", slog.Any("req", req))
+
+	var ruleset []rules.Rule
+	if req.Conditions != nil || req.Actions != nil {
+		draft, err := s.checkRule(ctx, req.UserId, req.Conditions, req.Actions)
+		if err != nil {
+			s.logger.Error("Invalid rule", slog.Any("error", err))
+			return nil, err
+		}
+		ruleset = []rules.Rule{draft}
+	} else {
+		var err error
+		ruleset, err = userRules(ctx, s.mongodb, req.UserId, req.Scope.GetRuleIds())
+		if err != nil {
+			s.logger.Error("Error while retrieving rules", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	response := &pb.PreviewRulesResponse{}
+	scanned, matched, err := s.runRules(ctx, req.UserId, ruleset, req.Scope, func(transaction bson.M, result rules.Result) error {
+		if len(response.Matches) >= limit {
+			return nil
+		}
+		response.Matches = append(response.Matches, &pb.RuleMatch{
+			TransactionId:  transaction["_id"].(primitive.ObjectID).Hex(),
+			Date:           transaction["date"].(primitive.DateTime).Time().Format("2006-01-02"),
+			Amount:         money.ToProto(storedAmount(transaction["amount"]), storedString(transaction["currency"])),
+			Description:    storedString(transaction["description"]),
+			CategoryId:     storedString(transaction["category_id"]),
+			Tags:           transactionTags(transaction),
+			NewCategoryId:  result.CategoryID,
+			AddTags:        result.AddTags,
+			NewDescription: result.Description,
+			RuleIds:        result.RuleIDs,
+		})
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Error while previewing rules", slog.Any("error", err))
+		return nil, err
+	}
+	response.Scanned = int32(scanned)
+	response.Matched = int32(matched)
+
+	return response, nil
+}
+
+// ApplyRules runs the user's rules over the transactions already recorded in
+// scope. It reports which transactions it gave a category, so budget alerts
+// can be checked for them.
+func (s *RuleStorage) ApplyRules(ctx context.Context, req *pb.ApplyRulesRequest) (*pb.ApplyRulesResponse, error) {
+	s.logger.Info("ApplyRules", slog.Any("req", req))
+
+	ruleset, err := userRules(ctx, s.mongodb, req.UserId, req.Scope.GetRuleIds())
+	if err != nil {
+		s.logger.Error("Error while retrieving rules", slog.Any("error", err))
+		return nil, err
+	}
+
+	var models []mongo.WriteModel
+	var recategorized []string
+	scanned, matched, err := s.runRules(ctx, req.UserId, ruleset, req.Scope, func(transaction bson.M, result rules.Result) error {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(ruleFilter(transaction, req.Scope.GetOverwriteCategories())).
+			SetUpdate(ruleUpdate(transaction, result)))
+		if result.CategoryID != "" {
+			recategorized = append(recategorized, transaction["_id"].(primitive.ObjectID).Hex())
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Error while applying rules", slog.Any("error", err))
+		return nil, err
+	}
+
+	response := &pb.ApplyRulesResponse{Scanned: int32(scanned), Matched: int32(matched)}
+	if len(models) > 0 {
+		res, err := s.mongodb.Collection("transactions").BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			s.logger.Error("Error while updating transactions", slog.Any("error", err))
+			return nil, err
+		}
+		response.Updated = int32(res.ModifiedCount)
+		response.RecategorizedIds = recategorized
+	}
+
+	return response, nil
+}
+
+// EnsureIndexes serves the lookup of a user's live rules in the order they
+// run, which every new transaction and import makes.
+func (s *RuleStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("rules").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "deleted_at", Value: 1},
+			{Key: "priority", Value: 1},
+			{Key: "created_at", Value: 1},
+		},
+		Options: options.Index().SetName("rule_user_order"),
+	})
+	if err != nil {
+		s.logger.Error("Error creating rule index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// runRules runs ruleset over the user's income and expense transactions in
+// scope and calls fn for each one the rules would change. It returns how many
+// transactions it looked at and how many would change. When the scope
+// overwrites categories, a transaction's own category does not keep the rules
+// from setting another.
+func (s *RuleStorage) runRules(ctx context.Context, userID string, ruleset []rules.Rule, scope *pb.RuleScope, fn func(transaction bson.M, result rules.Result) error) (int, int, error) {
+	if len(ruleset) == 0 {
+		return 0, 0, nil
+	}
+
+	categories, err := ruleCategories(ctx, s.mongodb, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	filter := bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "type", Value: bson.D{{Key: "$in", Value: bson.A{validation.Income, validation.Expense}}}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if scope.GetAccountId() != "" {
+		filter = append(filter, bson.E{Key: "account_id", Value: scope.AccountId})
+	}
+	dateRange := bson.D{}
+	if scope.GetStartDate() != "" {
+		start, err := time.Parse("2006-01-02", scope.StartDate)
+		if err != nil {
+			return 0, 0, validation.Field("scope.start_date", "%q is not a date in the form YYYY-MM-DD", scope.StartDate)
+		}
+		dateRange = append(dateRange, bson.E{Key: "$gte", Value: start})
+	}
+	if scope.GetEndDate() != "" {
+		end, err := time.Parse("2006-01-02", scope.EndDate)
+		if err != nil {
+			return 0, 0, validation.Field("scope.end_date", "%q is not a date in the form YYYY-MM-DD", scope.EndDate)
+		}
+		dateRange = append(dateRange, bson.E{Key: "$lte", Value: end})
+	}
+	if len(dateRange) > 0 {
+		filter = append(filter, bson.E{Key: "date", Value: dateRange})
+	}
+
+	cursor, err := s.mongodb.Collection("transactions").Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "date", Value: -1},
+		{Key: "_id", Value: -1},
+	}))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	scanned, matched := 0, 0
+	for cursor.Next(ctx) {
+		var transaction bson.M
+		if err := cursor.Decode(&transaction); err != nil {
+			return scanned, matched, err
+		}
+		scanned++
+
+		tx := ruleTransaction(transaction)
+		if scope.GetOverwriteCategories() {
+			tx.CategoryID = ""
+		}
+		result := rules.Apply(ruleset, tx, categories)
+		if result.CategoryID == storedString(transaction["category_id"]) {
+			result.CategoryID = ""
+		}
+		if !result.Changed() {
+			continue
+		}
+
+		matched++
+		if err := fn(transaction, result); err != nil {
+			return scanned, matched, err
+		}
+	}
+
+	return scanned, matched, cursor.Err()
+}
+
+// checkRule prepares a rule from a request and checks that the account and
+// category it names are the user's own, and that the category takes the
+// transactions the rule can match.
+func (s *RuleStorage) checkRule(ctx context.Context, userID string, conditions *pb.RuleConditions, actions *pb.RuleActions) (rules.Rule, error) {
+	var v validation.Violations
+	minAmount := ruleAmount(&v, "conditions.min_amount", conditions.GetMinAmount())
+	maxAmount := ruleAmount(&v, "conditions.max_amount", conditions.GetMaxAmount())
+	currency := conditions.GetMinAmount().GetCurrencyCode()
+	if code := conditions.GetMaxAmount().GetCurrencyCode(); code != "" {
+		if currency != "" && !strings.EqualFold(currency, code) {
+			v.Add("conditions.max_amount", "must be in the same currency as min_amount")
+		}
+		currency = code
+	}
+	if err := v.Err(); err != nil {
+		return rules.Rule{}, err
+	}
+
+	rule, err := rules.New("", rules.Conditions{
+		DescriptionContains: conditions.GetDescriptionContains(),
+		DescriptionRegex:    conditions.GetDescriptionRegex(),
+		MinAmount:           minAmount,
+		MaxAmount:           maxAmount,
+		Currency:            strings.ToUpper(currency),
+		AccountID:           conditions.GetAccountId(),
+		Type:                conditions.GetType(),
+	}, rules.Actions{
+		CategoryID:     actions.GetCategoryId(),
+		AddTags:        actions.GetAddTags(),
+		SetDescription: actions.GetSetDescription(),
+	})
+	if err != nil {
+		return rules.Rule{}, err
+	}
+
+	if rule.Conditions.AccountID != "" {
+		if _, err := accountCurrency(ctx, s.mongodb, userID, rule.Conditions.AccountID, "conditions.account_id"); err != nil {
+			return rules.Rule{}, err
+		}
+	}
+	if rule.Actions.CategoryID != "" {
+		if err := checkCategory(ctx, s.mongodb, userID, rule.Actions.CategoryID, rule.Conditions.Type, "actions.category_id"); err != nil {
+			return rules.Rule{}, err
+		}
+	}
+
+	return rule, nil
+}
+
+func ruleAmount(v *validation.Violations, field string, amount *common_pb.Money) int64 {
+	if amount == nil {
+		return 0
+	}
+	cents, err := money.FromProto(amount)
+	if err != nil {
+		v.Add(field, "%v", err)
+	}
+	return cents
+}
+
+func (s *RuleStorage) liveRule(ctx context.Context, id string) (*ruleDoc, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		s.logger.Error("Invalid ObjectID", slog.Any("error", err))
+		return nil, err
+	}
+
+	var doc ruleDoc
+	err = s.mongodb.Collection("rules").FindOne(ctx, bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Info("Rule not found", slog.String("id", id))
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Error while retrieving rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// findRules returns the live rules matching filter in the order they run:
+// lowest priority first, then oldest first.
+func findRules(ctx context.Context, db *mongo.Database, filter bson.D) ([]ruleDoc, error) {
+	filter = append(filter, bson.E{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}})
+	cursor, err := db.Collection("rules").Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "priority", Value: 1},
+		{Key: "created_at", Value: 1},
+		{Key: "_id", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []ruleDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// userRules returns the user's enabled rules in the order they run, only
+// those in ids when any are given.
+func userRules(ctx context.Context, db *mongo.Database, userID string, ids []string) ([]rules.Rule, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "disabled", Value: bson.D{{Key: "$ne", Value: true}}},
+	}
+	if len(ids) > 0 {
+		objIDs := make(bson.A, 0, len(ids))
+		for i, id := range ids {
+			objID, err := primitive.ObjectIDFromHex(id)
+			if err != nil {
+				return nil, validation.Field("scope.rule_ids", "rule_ids[%d] %q is not a valid id", i, id)
+			}
+			objIDs = append(objIDs, objID)
+		}
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: objIDs}}})
+	}
+
+	docs, err := findRules(ctx, db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleset := make([]rules.Rule, 0, len(docs))
+	for _, doc := range docs {
+		rule, err := rules.New(doc.ID.Hex(), rules.Conditions(doc.Conditions), rules.Actions(doc.Actions))
+		if err != nil {
+			// Rules are checked when they are saved; one that no longer
+			// passes is left out rather than holding up every transaction.
+			continue
+		}
+		ruleset = append(ruleset, rule)
+	}
+	return ruleset, nil
+}
+
+// runUserRules runs the user's rules over a transaction about to be recorded.
+func runUserRules(ctx context.Context, db *mongo.Database, userID string, tx rules.Transaction) (rules.Result, error) {
+	ruleset, err := userRules(ctx, db, userID, nil)
+	if err != nil || len(ruleset) == 0 {
+		return rules.Result{}, err
+	}
+	categories, err := ruleCategories(ctx, db, userID)
+	if err != nil {
+		return rules.Result{}, err
+	}
+	return rules.Apply(ruleset, tx, categories), nil
+}
+
+// ruleCategories maps the ids of the user's live, unarchived categories, the
+// ones rules may still set, to their types.
+func ruleCategories(ctx context.Context, db *mongo.Database, userID string) (map[string]string, error) {
+	cursor, err := db.Collection("categories").Find(ctx, bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		{Key: "archived_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}, options.Find().SetProjection(bson.D{{Key: "type", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []struct {
+		ID   primitive.ObjectID `bson:"_id"`
+		Type string             `bson:"type"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]string, len(docs))
+	for _, doc := range docs {
+		categories[doc.ID.Hex()] = doc.Type
+	}
+	return categories, nil
+}
+
+func ruleTransaction(transaction bson.M) rules.Transaction {
+	_, split := transaction["splits"].(bson.A)
+	return rules.Transaction{
+		AccountID:   storedString(transaction["account_id"]),
+		Type:        storedString(transaction["type"]),
+		Description: storedString(transaction["description"]),
+		Amount:      storedAmount(transaction["amount"]),
+		Currency:    storedString(transaction["currency"]),
+		CategoryID:  storedString(transaction["category_id"]),
+		Tags:        transactionTags(transaction),
+		Split:       split && len(transaction["splits"].(bson.A)) > 0,
+	}
+}
+
+// ruleUpdate writes what the rules did to a stored transaction. The bank's
+// own description is kept aside the first time a rule rewrites it, so a
+// re-imported statement still recognises the row.
+// ruleFilter matches a transaction the rules ran over only while it is as
+// they saw it: not deleted since, and, unless the scope overwrites categories,
+// still in the category it was read with.
+func ruleFilter(transaction bson.M, overwriteCategories bool) bson.D {
+	filter := bson.D{
+		{Key: "_id", Value: transaction["_id"]},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+	if !overwriteCategories {
+		filter = append(filter, bson.E{Key: "category_id", Value: transaction["category_id"]})
+	}
+	return filter
+}
+
+func ruleUpdate(transaction bson.M, result rules.Result) bson.D {
+	set := bson.D{{Key: "updated_at", Value: time.Now()}}
+	if result.CategoryID != "" {
+		set = append(set, bson.E{Key: "category_id", Value: result.CategoryID})
+	}
+	if result.Description != "" {
+		set = append(set, bson.E{Key: "description", Value: result.Description})
+		if _, ok := transaction["original_description"]; !ok {
+			set = append(set, bson.E{Key: "original_description", Value: storedString(transaction["description"])})
+		}
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+	if len(result.AddTags) > 0 {
+		update = append(update, bson.E{Key: "$addToSet", Value: bson.D{
+			{Key: "tags", Value: bson.D{{Key: "$each", Value: result.AddTags}}},
+		}})
+	}
+	return update
+}
+
+func ruleResponse(doc ruleDoc) *pb.RuleResponse {
+	c, a := doc.Conditions, doc.Actions
+	conditions := &pb.RuleConditions{
+		DescriptionContains: c.DescriptionContains,
+		DescriptionRegex:    c.DescriptionRegex,
+		AccountId:           c.AccountID,
+		Type:                c.Type,
+	}
+	if c.MinAmount > 0 {
+		conditions.MinAmount = money.ToProto(c.MinAmount, c.Currency)
+	}
+	if c.MaxAmount > 0 {
+		conditions.MaxAmount = money.ToProto(c.MaxAmount, c.Currency)
+	}
+
+	return &pb.RuleResponse{
+		Id:         doc.ID.Hex(),
+		UserId:     doc.UserID,
+		Name:       doc.Name,
+		Priority:   doc.Priority,
+		Conditions: conditions,
+		Actions: &pb.RuleActions{
+			CategoryId:     a.CategoryID,
+			AddTags:        a.AddTags,
+			SetDescription: a.SetDescription,
+		},
+		Disabled:  doc.Disabled,
+		CreatedAt: doc.CreatedAt.String(),
+		UpdatedAt: doc.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,196 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	common_pb "budgeting-service/genproto/common"
+	pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/exporter"
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ExportTransactions walks the user's matching transactions with a cursor and
+// hands them to fn one at a time, oldest first. When byAccount is set the
+// transactions of each account come together.
+func (s *TransactionStorage) ExportTransactions(ctx context.Context, req *pb.ExportTransactionsRequest, byAccount bool, fn func(*exporter.Transaction) error) error {
+	s.logger.Info("ExportTransactions", slog.Any("req", req))
+
+	if req.UserId == "" {
+		return errors.New("user id is required")
+	}
+
+	filter, err := transactionsFilter(&pb.GetTransactionsRequest{
+		UserId:     req.UserId,
+		AccountId:  req.AccountId,
+		CategoryId: req.CategoryId,
+		Type:       req.Type,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Search:     req.Search,
+	})
+	if err != nil {
+		s.logger.Error("Invalid transaction filter", slog.Any("error", err))
+		return err
+	}
+
+	sort := bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}}
+	if byAccount {
+		sort = append(bson.D{{Key: "account_id", Value: 1}}, sort...)
+	}
+
+	cursor, err := s.mongodb.Collection("transactions").Find(ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		s.logger.Error("Error while fetching transactions", slog.Any("error", err))
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	names := newExportNames(s.mongodb)
+	count := 0
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			s.logger.Error("Error while decoding transaction", slog.Any("error", err))
+			return err
+		}
+
+		transaction, err := names.transaction(ctx, doc)
+		if err != nil {
+			s.logger.Error("Error while resolving transaction names", slog.Any("error", err))
+			return err
+		}
+
+		if err := fn(transaction); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		s.logger.Error("Error while reading transactions", slog.Any("error", err))
+		return err
+	}
+
+	s.logger.Info("Transactions exported", slog.String("user_id", req.UserId), slog.Int("count", count))
+	return nil
+}
+
+type exportAccount struct {
+	Name     string `bson:"name"`
+	Currency string `bson:"currency"`
+}
+
+// exportNames resolves and caches account and category names for the length
+// of one export. Deleted accounts and categories still resolve, since their
+// transactions may predate the deletion.
+type exportNames struct {
+	mongodb    *mongo.Database
+	accounts   map[string]exportAccount
+	categories map[string]string
+}
+
+func newExportNames(mongodb *mongo.Database) *exportNames {
+	return &exportNames{
+		mongodb:    mongodb,
+		accounts:   make(map[string]exportAccount),
+		categories: make(map[string]string),
+	}
+}
+
+func (n *exportNames) transaction(ctx context.Context, doc bson.M) (*exporter.Transaction, error) {
+	response := transactionResponse(doc)
+
+	account, err := n.account(ctx, response.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &exporter.Transaction{
+		ID:          response.Id,
+		Date:        doc["date"].(primitive.DateTime).Time().UTC(),
+		AccountID:   response.AccountId,
+		AccountName: account.Name,
+		Currency:    response.Amount.GetCurrencyCode(),
+		CategoryID:  response.CategoryId,
+		Type:        response.Type,
+		Amount:      exportAmount(response.Amount),
+		Description: response.Description,
+		TransferID:  response.TransferId,
+		ExternalID:  response.ExternalId,
+	}
+
+	if transaction.Currency == "" {
+		transaction.Currency = account.Currency
+	}
+
+	if transaction.CategoryName, err = n.category(ctx, response.CategoryId); err != nil {
+		return nil, err
+	}
+	for _, split := range response.Splits {
+		name, err := n.category(ctx, split.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.Splits = append(transaction.Splits, exporter.Split{
+			CategoryID:   split.CategoryId,
+			CategoryName: name,
+			Amount:       exportAmount(split.Amount),
+		})
+	}
+
+	return transaction, nil
+}
+
+func (n *exportNames) account(ctx context.Context, id string) (exportAccount, error) {
+	if account, ok := n.accounts[id]; ok {
+		return account, nil
+	}
+
+	var account exportAccount
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		err = n.mongodb.Collection("accounts").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&account)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return account, err
+		}
+	}
+
+	n.accounts[id] = account
+	return account, nil
+}
+
+func (n *exportNames) category(ctx context.Context, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+	if name, ok := n.categories[id]; ok {
+		return name, nil
+	}
+
+	var category struct {
+		Name string `bson:"name"`
+	}
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		err = n.mongodb.Collection("categories").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&category)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return "", err
+		}
+	}
+
+	n.categories[id] = category.Name
+	return category.Name, nil
+}
+
+// exportAmount reads back an amount built by transactionResponse, which is
+// always a valid Money.
+func exportAmount(amount *common_pb.Money) exporter.Amount {
+	cents, _ := money.FromProto(amount)
+	return exporter.Amount(cents)
+}
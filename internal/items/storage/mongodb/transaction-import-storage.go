@@ -0,0 +1,365 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	pb "budgeting-service/genproto/transaction"
+	"budgeting-service/internal/items/importer"
+	"budgeting-service/internal/items/money"
+	"budgeting-service/internal/items/rules"
+	"budgeting-service/internal/items/validation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	importStatusImported  = "imported"
+	importStatusDuplicate = "duplicate"
+	importStatusFailed    = "failed"
+)
+
+func (s *TransactionStorage) ImportTransactions(ctx context.Context, req *pb.ImportTransactionsRequest) (*pb.ImportTransactionsResponse, error) {
+	s.logger.Info("ImportTransactions", slog.String("user_id", req.UserId), slog.String("account_id", req.AccountId), slog.Int("bytes", len(req.Csv)))
+
+	if req.Mapping == nil {
+		return nil, validation.Field("mapping", "is required")
+	}
+
+	currency, err := accountCurrency(ctx, s.mongodb, req.UserId, req.AccountId, "account_id")
+	if err != nil {
+		s.logger.Error("Error loading import account", slog.Any("error", err))
+		return nil, err
+	}
+
+	rows, err := importer.ParseCSV(req.Csv, importer.CSVMapping{
+		Delimiter:         req.Mapping.Delimiter,
+		HasHeader:         req.Mapping.HasHeader,
+		DateColumn:        req.Mapping.DateColumn,
+		DateFormat:        req.Mapping.DateFormat,
+		AmountColumn:      req.Mapping.AmountColumn,
+		DebitColumn:       req.Mapping.DebitColumn,
+		CreditColumn:      req.Mapping.CreditColumn,
+		DescriptionColumn: req.Mapping.DescriptionColumn,
+		AmountSign:        req.Mapping.AmountSign,
+		DecimalSeparator:  req.Mapping.DecimalSeparator,
+	})
+	if err != nil {
+		s.logger.Error("Error parsing CSV", slog.Any("error", err))
+		return nil, err
+	}
+
+	return s.importRows(ctx, req.UserId, req.AccountId, currency, req.CategoryId, rows)
+}
+
+func (s *TransactionStorage) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportTransactionsResponse, error) {
+	s.logger.Info("ImportStatement", slog.String("user_id", req.UserId), slog.String("account_id", req.AccountId), slog.String("format", req.Format), slog.Int("bytes", len(req.Data)))
+
+	currency, err := accountCurrency(ctx, s.mongodb, req.UserId, req.AccountId, "account_id")
+	if err != nil {
+		s.logger.Error("Error loading import account", slog.Any("error", err))
+		return nil, err
+	}
+
+	statement, err := importer.ParseStatement(req.Format, req.Data, req.DateFormat)
+	if err != nil {
+		s.logger.Error("Error parsing statement", slog.Any("error", err))
+		return nil, err
+	}
+	if statement.Currency != "" && !strings.EqualFold(statement.Currency, currency) {
+		err := validation.Field("data", "statement is in %s but the account holds %s", statement.Currency, currency)
+		s.logger.Error("Statement currency mismatch", slog.Any("error", err))
+		return nil, err
+	}
+
+	response, err := s.importRows(ctx, req.UserId, req.AccountId, currency, req.CategoryId, statement.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if statement.LedgerBalance != nil {
+		response.BalanceCheck, err = s.balanceCheck(ctx, req.AccountId, currency, *statement.LedgerBalance, statement.LedgerDate)
+		if err != nil {
+			s.logger.Error("Error checking ledger balance", slog.Any("error", err))
+			return nil, err
+		}
+		if response.BalanceCheck.Difference.Units != 0 || response.BalanceCheck.Difference.Nanos != 0 {
+			s.logger.Warn("Statement ledger balance differs from account balance",
+				slog.String("account_id", req.AccountId),
+				slog.Any("balance_check", response.BalanceCheck),
+			)
+		}
+	}
+
+	return response, nil
+}
+
+// balanceCheck compares the closing balance reported by the bank with the
+// balance the account holds after the import. A difference usually means
+// transactions before the statement period were never recorded.
+func (s *TransactionStorage) balanceCheck(ctx context.Context, accountID, currency string, ledgerBalance int64, asOf time.Time) (*pb.BalanceCheck, error) {
+	objID, err := primitive.ObjectIDFromHex(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var account struct {
+		Balance int64 `bson:"balance"`
+	}
+	err = s.mongodb.Collection("accounts").FindOne(ctx, bson.D{{Key: "_id", Value: objID}}).Decode(&account)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &pb.BalanceCheck{
+		LedgerBalance:  money.ToProto(ledgerBalance, currency),
+		AccountBalance: money.ToProto(account.Balance, currency),
+		Difference:     money.ToProto(ledgerBalance-account.Balance, currency),
+	}
+	if !asOf.IsZero() {
+		check.AsOf = asOf.Format("2006-01-02")
+	}
+
+	return check, nil
+}
+
+// importRows stores the parsed rows of a statement in one transaction, so an
+// import either lands completely or not at all. A row that carries the bank's
+// transaction ID is a duplicate when the account already holds that ID, even
+// on a deleted transaction, so a re-imported file never brings back what the
+// user removed. Other rows count as duplicates when the account already holds
+// a transaction with the same date, amount and description; identical rows
+// within one file are matched one for one, so two equal purchases on the same
+// day both survive a first import and are both skipped on a second one.
+// The user's rules run over every imported row; a category they set wins over
+// categoryID, which is left for the rows no rule categorizes. A row whose type
+// categoryID does not take fails on its own rather than being misfiled.
+func (s *TransactionStorage) importRows(ctx context.Context, userID, accountID, currency, categoryID string, rows []importer.Row) (*pb.ImportTransactionsResponse, error) {
+	transactionCollection := s.mongodb.Collection("transactions")
+	created_at := time.Now()
+
+	categoryErrs, err := importCategoryErrs(ctx, s.mongodb, userID, categoryID)
+	if err != nil {
+		s.logger.Error("Error while checking import category", slog.Any("error", err))
+		return nil, err
+	}
+
+	ruleset, err := userRules(ctx, s.mongodb, userID, nil)
+	if err != nil {
+		s.logger.Error("Error while loading rules", slog.Any("error", err))
+		return nil, err
+	}
+	var categories map[string]string
+	if len(ruleset) > 0 {
+		if categories, err = ruleCategories(ctx, s.mongodb, userID); err != nil {
+			s.logger.Error("Error while loading rule categories", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	var response *pb.ImportTransactionsResponse
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		response = &pb.ImportTransactionsResponse{}
+
+		type rowKey struct {
+			date        time.Time
+			amount      int64
+			description string
+		}
+		seen := make(map[rowKey]int64)
+		seenExternal := make(map[string]bool)
+
+		var docs []interface{}
+		var delta int64
+
+		for _, row := range rows {
+			result := &pb.ImportRowResult{Row: int32(row.Line)}
+			response.Rows = append(response.Rows, result)
+
+			if row.Err != nil {
+				result.Status = importStatusFailed
+				result.Error = row.Err.Error()
+				response.Failed++
+				continue
+			}
+
+			key := rowKey{date: row.Date, amount: row.Amount, description: row.Description}
+
+			var duplicate bool
+			var err error
+			if row.ExternalID != "" {
+				duplicate = seenExternal[row.ExternalID]
+				seenExternal[row.ExternalID] = true
+				if !duplicate {
+					duplicate, err = s.isDuplicateRow(sc, accountID, row, 0)
+				}
+			} else {
+				seen[key]++
+				duplicate, err = s.isDuplicateRow(sc, accountID, row, seen[key])
+			}
+			if err != nil {
+				return err
+			}
+			if duplicate {
+				result.Status = importStatusDuplicate
+				response.Duplicates++
+				continue
+			}
+
+			ruled := rules.Apply(ruleset, rules.Transaction{
+				AccountID:   accountID,
+				Type:        row.Type,
+				Description: row.Description,
+				Amount:      row.Amount,
+				Currency:    currency,
+			}, categories)
+			rowCategoryID := categoryID
+			if ruled.CategoryID != "" {
+				rowCategoryID = ruled.CategoryID
+			} else if err := categoryErrs[row.Type]; err != nil {
+				result.Status = importStatusFailed
+				result.Error = err.Error()
+				response.Failed++
+				continue
+			}
+			description := row.Description
+			if ruled.Description != "" {
+				description = ruled.Description
+			}
+
+			id := primitive.NewObjectID()
+			doc := bson.D{
+				{Key: "_id", Value: id},
+				{Key: "user_id", Value: userID},
+				{Key: "account_id", Value: accountID},
+				{Key: "category_id", Value: rowCategoryID},
+				{Key: "amount", Value: row.Amount},
+				{Key: "currency", Value: currency},
+				{Key: "type", Value: row.Type},
+				{Key: "description", Value: description},
+				{Key: "date", Value: row.Date},
+				{Key: "created_at", Value: created_at},
+				{Key: "updated_at", Value: created_at},
+				{Key: "deleted_at", Value: nil},
+			}
+			if row.ExternalID != "" {
+				doc = append(doc, bson.E{Key: "external_id", Value: row.ExternalID})
+			}
+			if description != row.Description {
+				doc = append(doc, bson.E{Key: "original_description", Value: row.Description})
+			}
+			if len(ruled.AddTags) > 0 {
+				doc = append(doc, bson.E{Key: "tags", Value: ruled.AddTags})
+			}
+			docs = append(docs, doc)
+			delta += balanceDelta(row.Type, row.Amount)
+
+			result.Status = importStatusImported
+			result.TransactionId = id.Hex()
+		}
+
+		if len(docs) == 0 {
+			return nil
+		}
+
+		if _, err := transactionCollection.InsertMany(sc, docs); err != nil {
+			return err
+		}
+		response.Imported = int32(len(docs))
+
+		return s.adjustBalance(sc, accountID, delta)
+	})
+	if err != nil {
+		s.logger.Error("Error while importing transactions", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.logger.Info("Transactions imported",
+		slog.String("account_id", accountID),
+		slog.Int("imported", int(response.Imported)),
+		slog.Int("duplicates", int(response.Duplicates)),
+		slog.Int("failed", int(response.Failed)),
+	)
+
+	return response, nil
+}
+
+// importCategoryErrs checks the category an import files uncategorized rows
+// under. The request fails when the category cannot be used at all; otherwise
+// the result holds, for each transaction type the category does not take, the
+// error its rows fail with.
+func importCategoryErrs(ctx context.Context, db *mongo.Database, userID, categoryID string) (map[string]error, error) {
+	if categoryID == "" {
+		return nil, nil
+	}
+	if err := checkCategory(ctx, db, userID, categoryID, "", "category_id"); err != nil {
+		return nil, err
+	}
+
+	errs := make(map[string]error)
+	for _, transactionType := range []string{validation.Income, validation.Expense} {
+		err := checkCategory(ctx, db, userID, categoryID, transactionType, "category_id")
+		var invalid *validation.Error
+		if err != nil && !errors.As(err, &invalid) {
+			return nil, err
+		}
+		errs[transactionType] = err
+	}
+	return errs, nil
+}
+
+// isDuplicateRow reports whether a statement row is already in the account.
+// For rows without a bank transaction ID, occurrence counts the rows with the
+// same date, amount and description seen so far in the file, this one
+// included; a transaction whose description a rule rewrote is matched on the
+// description it arrived with.
+func (s *TransactionStorage) isDuplicateRow(sc mongo.SessionContext, accountID string, row importer.Row, occurrence int64) (bool, error) {
+	transactionCollection := s.mongodb.Collection("transactions")
+
+	if row.ExternalID != "" {
+		existing, err := transactionCollection.CountDocuments(sc, bson.D{
+			{Key: "account_id", Value: accountID},
+			{Key: "external_id", Value: row.ExternalID},
+		})
+		return existing > 0, err
+	}
+
+	existing, err := transactionCollection.CountDocuments(sc, bson.D{
+		{Key: "account_id", Value: accountID},
+		{Key: "date", Value: row.Date},
+		{Key: "amount", Value: row.Amount},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "description", Value: row.Description}},
+			bson.D{{Key: "original_description", Value: row.Description}},
+		}},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	})
+	return existing >= occurrence, err
+}
+
+// EnsureIndexes makes a bank transaction ID unique per account, so two imports
+// of the same statement racing each other cannot both insert a row.
+func (s *TransactionStorage) EnsureIndexes(ctx context.Context) error {
+	_, err := s.mongodb.Collection("transactions").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "account_id", Value: 1},
+			{Key: "external_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("statement_external_id").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "external_id", Value: bson.D{{Key: "$type", Value: "string"}}}}),
+	})
+	if err != nil {
+		s.logger.Error("Error creating statement external id index", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
@@ -2,10 +2,18 @@ package mongodb
 
 import (
 	"budgeting-service/internal/items/config"
+	"budgeting-service/internal/items/money"
 	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/rules"
+	"budgeting-service/internal/items/validation"
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	common_pb "budgeting-service/genproto/common"
 	pb "budgeting-service/genproto/transaction"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,6 +24,8 @@ import (
 	"log/slog"
 )
 
+var errTransferLegUpdate = errors.New("transfer legs cannot be updated, delete the transfer and create it again")
+
 type TransactionStorage struct {
 	mongodb *mongo.Database
 	cfg     *config.Config
@@ -39,39 +49,139 @@ func (s *TransactionStorage) CreateTransaction(ctx context.Context, req *pb.Crea
 	date, err := time.Parse("2006-01-02", req.Date)
 	if err != nil {
 		s.logger.Error("Error parsing start date", slog.Any("error", err))
+		return nil, validation.Field("date", "%q is not a date in the form YYYY-MM-DD", req.Date)
+	}
+
+	amount, err := money.FromProto(req.Amount)
+	if err != nil {
+		s.logger.Error("Invalid amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+
+	if err := validateSplits(amount, req.Splits); err != nil {
+		s.logger.Error("Invalid splits", slog.Any("error", err))
 		return nil, err
 	}
 
-	transactionDoc := bson.D{
-		{Key: "user_id", Value: req.UserId},
-		{Key: "account_id", Value: req.AccountId},
-		{Key: "category_id", Value: req.CategoryId},
-		{Key: "amount", Value: req.Amount},
-		{Key: "type", Value: req.Type},
-		{Key: "description", Value: req.Description},
-		{Key: "date", Value: date},
-		{Key: "created_at", Value: created_at},
-		{Key: "updated_at", Value: created_at},
-		{Key: "deleted_at", Value: nil},
+	splits, err := splitDocs(req.Splits)
+	if err != nil {
+		s.logger.Error("Invalid splits", slog.Any("error", err))
+		return nil, err
 	}
 
-	res, err := transactionCollection.InsertOne(ctx, transactionDoc)
+	var transactionID, currency, categoryID, description string
+	var tags []string
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		currency, err = accountCurrency(sc, s.mongodb, req.UserId, req.AccountId, "account_id")
+		if err != nil {
+			return err
+		}
+		if _, err := money.Amount(req.Amount, currency); err != nil {
+			return validation.Field("amount", "%v", err)
+		}
+
+		categoryID, description, tags = req.CategoryId, req.Description, rules.Tags(req.Tags)
+		result, err := runUserRules(sc, s.mongodb, req.UserId, rules.Transaction{
+			AccountID:   req.AccountId,
+			Type:        req.Type,
+			Description: description,
+			Amount:      amount,
+			Currency:    currency,
+			CategoryID:  categoryID,
+			Tags:        tags,
+			Split:       len(req.Splits) > 0,
+		})
+		if err != nil {
+			return err
+		}
+		if result.CategoryID != "" {
+			categoryID = result.CategoryID
+		}
+		if result.Description != "" {
+			description = result.Description
+		}
+		tags = append(tags, result.AddTags...)
+
+		if err := checkTransactionCategories(sc, s.mongodb, req.UserId, req.Type, categoryID, req.Splits); err != nil {
+			return err
+		}
+		if req.GoalId != "" {
+			if err := checkGoal(sc, s.mongodb, req.UserId, req.GoalId); err != nil {
+				return err
+			}
+		}
+
+		transactionDoc := bson.D{
+			{Key: "user_id", Value: req.UserId},
+			{Key: "account_id", Value: req.AccountId},
+			{Key: "category_id", Value: categoryID},
+			{Key: "amount", Value: amount},
+			{Key: "currency", Value: currency},
+			{Key: "type", Value: req.Type},
+			{Key: "description", Value: description},
+			{Key: "date", Value: date},
+			{Key: "created_at", Value: created_at},
+			{Key: "updated_at", Value: created_at},
+			{Key: "deleted_at", Value: nil},
+		}
+		if description != req.Description {
+			transactionDoc = append(transactionDoc, bson.E{Key: "original_description", Value: req.Description})
+		}
+		if len(tags) > 0 {
+			transactionDoc = append(transactionDoc, bson.E{Key: "tags", Value: tags})
+		}
+		if req.RecurringId != "" {
+			transactionDoc = append(transactionDoc, bson.E{Key: "recurring_id", Value: req.RecurringId})
+		}
+		if req.GoalId != "" {
+			transactionDoc = append(transactionDoc, bson.E{Key: "goal_id", Value: req.GoalId})
+		}
+		if len(splits) > 0 {
+			transactionDoc = append(transactionDoc, bson.E{Key: "splits", Value: splits})
+		}
+
+		res, err := transactionCollection.InsertOne(sc, transactionDoc)
+		if err != nil {
+			return err
+		}
+		transactionID = res.InsertedID.(primitive.ObjectID).Hex()
+
+		if req.GoalId != "" {
+			err := syncGoalContribution(sc, s.mongodb, bson.M{
+				"_id":         res.InsertedID,
+				"goal_id":     req.GoalId,
+				"user_id":     req.UserId,
+				"account_id":  req.AccountId,
+				"amount":      amount,
+				"currency":    currency,
+				"description": description,
+				"date":        date,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.adjustBalance(sc, req.AccountId, balanceDelta(req.Type, amount))
+	})
 	if err != nil {
 		s.logger.Error("Error while creating transaction", slog.Any("error", err))
 		return nil, err
 	}
 
-	transactionID := res.InsertedID.(primitive.ObjectID).Hex()
-
 	return &pb.TransactionResponse{
 		Id:          transactionID,
 		UserId:      req.UserId,
 		AccountId:   req.AccountId,
-		CategoryId:  req.CategoryId,
-		Amount:      req.Amount,
+		CategoryId:  categoryID,
+		Amount:      money.ToProto(amount, currency),
 		Type:        req.Type,
-		Description: req.Description,
+		Description: description,
 		Date:        req.Date,
+		RecurringId: req.RecurringId,
+		GoalId:      req.GoalId,
+		Splits:      req.Splits,
+		Tags:        tags,
 		CreatedAt:   created_at.String(),
 	}, nil
 }
@@ -81,7 +191,51 @@ func (s *TransactionStorage) GetTransactions(ctx context.Context, req *pb.GetTra
 
 	transactionCollection := s.mongodb.Collection("transactions")
 
-	filter := bson.D{}
+	filter, err := transactionsFilter(req)
+	if err != nil {
+		s.logger.Error("Invalid transaction filter", slog.Any("error", err))
+		return nil, err
+	}
+
+	page, err := newPage(req.SortBy, req.SortOrder, map[string]string{
+		"date":   "date",
+		"amount": "amount",
+	}, "date", req.PageSize, req.PageToken)
+	if err != nil {
+		s.logger.Error("Invalid page request", slog.Any("error", err))
+		return nil, err
+	}
+
+	cursor, err := transactionCollection.Find(ctx, page.filter(filter), page.findOptions())
+	if err != nil {
+		s.logger.Error("Error while fetching transactions", slog.Any("error", err))
+		return nil, err
+	}
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		s.logger.Error("Error while decoding transactions", slog.Any("error", err))
+		return nil, err
+	}
+
+	docs, nextPageToken, err := page.trim(docs)
+	if err != nil {
+		s.logger.Error("Error while building page token", slog.Any("error", err))
+		return nil, err
+	}
+
+	var transactions []*pb.TransactionResponse
+	for _, transaction := range docs {
+		transactions = append(transactions, transactionResponse(transaction))
+	}
+
+	return &pb.TransactionsResponse{Transactions: transactions, NextPageToken: nextPageToken}, nil
+}
+
+// transactionsFilter builds the query shared by GetTransactions and
+// ExportTransactions.
+func transactionsFilter(req *pb.GetTransactionsRequest) (bson.D, error) {
+	filter := bson.D{{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}}}
 	if req.UserId != "" {
 		filter = append(filter, bson.E{Key: "user_id", Value: req.UserId})
 	}
@@ -91,42 +245,58 @@ func (s *TransactionStorage) GetTransactions(ctx context.Context, req *pb.GetTra
 	if req.CategoryId != "" {
 		filter = append(filter, bson.E{Key: "category_id", Value: req.CategoryId})
 	}
-
-	cursor, err := transactionCollection.Find(ctx, filter)
-	if err != nil {
-		s.logger.Error("Error while fetching transactions", slog.Any("error", err))
-		return nil, err
+	if req.Type != "" {
+		filter = append(filter, bson.E{Key: "type", Value: req.Type})
+	}
+	if req.Tag != "" {
+		filter = append(filter, bson.E{Key: "tags", Value: strings.ToLower(strings.TrimSpace(req.Tag))})
+	}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "description", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(req.Search)},
+			{Key: "$options", Value: "i"},
+		}})
 	}
-	defer cursor.Close(ctx)
 
-	var transactions []*pb.TransactionResponse
-	for cursor.Next(ctx) {
-		var transaction bson.M
-		if err := cursor.Decode(&transaction); err != nil {
-			s.logger.Error("Error while decoding transaction", slog.Any("error", err))
-			return nil, err
+	dateRange := bson.D{}
+	if req.StartDate != "" {
+		startDate, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, validation.Field("start_date", "%q is not a date in the form YYYY-MM-DD", req.StartDate)
 		}
-
-		transactions = append(transactions, &pb.TransactionResponse{
-			Id:          transaction["_id"].(primitive.ObjectID).Hex(),
-			UserId:      transaction["user_id"].(string),
-			AccountId:   transaction["account_id"].(string),
-			CategoryId:  transaction["category_id"].(string),
-			Amount:      float32(transaction["amount"].(float64)),
-			Type:        transaction["type"].(string),
-			Description: transaction["description"].(string),
-			Date:        transaction["date"].(primitive.DateTime).Time().String(),
-			CreatedAt:   transaction["created_at"].(primitive.DateTime).Time().String(),
-			// UpdatedAt:   transaction["updated_at"].(primitive.DateTime).Time().String(),
-		})
+		dateRange = append(dateRange, bson.E{Key: "$gte", Value: startDate})
+	}
+	if req.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, validation.Field("end_date", "%q is not a date in the form YYYY-MM-DD", req.EndDate)
+		}
+		dateRange = append(dateRange, bson.E{Key: "$lte", Value: endDate})
+	}
+	if len(dateRange) > 0 {
+		filter = append(filter, bson.E{Key: "date", Value: dateRange})
 	}
 
-	if err := cursor.Err(); err != nil {
-		s.logger.Error("Cursor error", slog.Any("error", err))
-		return nil, err
+	amountRange := bson.D{}
+	if req.MinAmount != nil {
+		minAmount, err := money.FromProto(req.MinAmount)
+		if err != nil {
+			return nil, validation.Field("min_amount", "%v", err)
+		}
+		amountRange = append(amountRange, bson.E{Key: "$gte", Value: minAmount})
+	}
+	if req.MaxAmount != nil {
+		maxAmount, err := money.FromProto(req.MaxAmount)
+		if err != nil {
+			return nil, validation.Field("max_amount", "%v", err)
+		}
+		amountRange = append(amountRange, bson.E{Key: "$lte", Value: maxAmount})
+	}
+	if len(amountRange) > 0 {
+		filter = append(filter, bson.E{Key: "amount", Value: amountRange})
 	}
 
-	return &pb.TransactionsResponse{Transactions: transactions}, nil
+	return filter, nil
 }
 
 func (s *TransactionStorage) GetTransactionById(ctx context.Context, req *pb.GetTransactionByIdRequest) (*pb.TransactionResponse, error) {
@@ -153,18 +323,7 @@ func (s *TransactionStorage) GetTransactionById(ctx context.Context, req *pb.Get
 		return nil, err
 	}
 
-	return &pb.TransactionResponse{
-		Id:          transaction["_id"].(primitive.ObjectID).Hex(),
-		UserId:      transaction["user_id"].(string),
-		AccountId:   transaction["account_id"].(string),
-		CategoryId:  transaction["category_id"].(string),
-		Amount:      float32(transaction["amount"].(float64)),
-		Type:        transaction["type"].(string),
-		Description: transaction["description"].(string),
-		Date:        transaction["date"].(primitive.DateTime).Time().String(),
-		CreatedAt:   transaction["created_at"].(primitive.DateTime).Time().String(),
-		// UpdatedAt:   transaction["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return transactionResponse(transaction), nil
 }
 
 func (s *TransactionStorage) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.TransactionResponse, error) {
@@ -178,10 +337,21 @@ func (s *TransactionStorage) UpdateTransaction(ctx context.Context, req *pb.Upda
 		return nil, err
 	}
 
-	filter := bson.D{{Key: "_id", Value: objID}}
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
 	updateFields := bson.D{}
-	if req.Amount != 0 {
-		updateFields = append(updateFields, bson.E{Key: "amount", Value: req.Amount})
+	if req.AccountId != "" {
+		updateFields = append(updateFields, bson.E{Key: "account_id", Value: req.AccountId})
+	}
+	if req.Amount != nil {
+		amount, err := money.FromProto(req.Amount)
+		if err != nil {
+			s.logger.Error("Invalid amount", slog.Any("error", err))
+			return nil, validation.Field("amount", "%v", err)
+		}
+		updateFields = append(updateFields, bson.E{Key: "amount", Value: amount})
 	}
 	if req.Type != "" {
 		updateFields = append(updateFields, bson.E{Key: "type", Value: req.Type})
@@ -189,14 +359,33 @@ func (s *TransactionStorage) UpdateTransaction(ctx context.Context, req *pb.Upda
 	if req.Description != "" {
 		updateFields = append(updateFields, bson.E{Key: "description", Value: req.Description})
 	}
+	if req.CategoryId != "" {
+		updateFields = append(updateFields, bson.E{Key: "category_id", Value: req.CategoryId})
+	}
+	// Splits given replace the stored ones; clear_splits turns the
+	// transaction back into one booked to its category alone.
+	if len(req.Splits) > 0 || req.ClearSplits {
+		splits, err := splitDocs(req.Splits)
+		if err != nil {
+			s.logger.Error("Invalid splits", slog.Any("error", err))
+			return nil, err
+		}
+		updateFields = append(updateFields, bson.E{Key: "splits", Value: splits})
+	}
+	if tags, ok := rules.TagUpdate(req.Tags, req.ReplaceTags); ok {
+		updateFields = append(updateFields, bson.E{Key: "tags", Value: tags})
+	}
 	if req.Date != "" {
 		date, err := time.Parse("2006-01-02", req.Date)
 		if err != nil {
 			s.logger.Error("Error parsing start date", slog.Any("error", err))
-			return nil, err
+			return nil, validation.Field("date", "%q is not a date in the form YYYY-MM-DD", req.Date)
 		}
 		updateFields = append(updateFields, bson.E{Key: "date", Value: date})
 	}
+	if req.GoalId != "" {
+		updateFields = append(updateFields, bson.E{Key: "goal_id", Value: req.GoalId})
+	}
 	if len(updateFields) > 0 {
 		updateFields = append(updateFields, bson.E{Key: "updated_at", Value: time.Now()})
 	}
@@ -206,36 +395,72 @@ func (s *TransactionStorage) UpdateTransaction(ctx context.Context, req *pb.Upda
 		return nil, nil
 	}
 
-	update := bson.D{{Key: "$set", Value: updateFields}}
+	var updatedTransaction bson.M
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		var oldTransaction balanceEffect
+		if err := transactionCollection.FindOne(sc, filter).Decode(&oldTransaction); err != nil {
+			return err
+		}
+		if oldTransaction.TransferID != "" {
+			return errTransferLegUpdate
+		}
+
+		if req.GoalId != "" {
+			if err := checkGoal(sc, s.mongodb, oldTransaction.UserID, req.GoalId); err != nil {
+				return err
+			}
+		}
+
+		set := append(bson.D{}, updateFields...)
+		if req.AccountId != "" && req.AccountId != oldTransaction.AccountID {
+			currency, err := accountCurrency(sc, s.mongodb, oldTransaction.UserID, req.AccountId, "account_id")
+			if err != nil {
+				return err
+			}
+			if _, err := money.Amount(req.Amount, currency); err != nil {
+				return validation.Field("amount", "%v", err)
+			}
+			set = append(set, bson.E{Key: "currency", Value: currency})
+		} else if _, err := money.Amount(req.Amount, oldTransaction.Currency); err != nil {
+			return validation.Field("amount", "%v", err)
+		}
+
+		update := bson.D{{Key: "$set", Value: set}}
+		res := transactionCollection.FindOneAndUpdate(sc, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+		if err := res.Decode(&updatedTransaction); err != nil {
+			return err
+		}
 
-	res := transactionCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
-	if res.Err() != nil {
-		if res.Err() == mongo.ErrNoDocuments {
+		splits := transactionSplits(updatedTransaction)
+		if err := validateSplits(storedAmount(updatedTransaction["amount"]), splits); err != nil {
+			return err
+		}
+		if req.Type != "" || req.CategoryId != "" || len(req.Splits) > 0 {
+			transactionType := storedString(updatedTransaction["type"])
+			if err := checkTransactionCategories(sc, s.mongodb, oldTransaction.UserID, transactionType, storedString(updatedTransaction["category_id"]), splits); err != nil {
+				return err
+			}
+		}
+		if err := syncGoalContribution(sc, s.mongodb, updatedTransaction); err != nil {
+			return err
+		}
+
+		if err := s.adjustBalance(sc, oldTransaction.AccountID, -balanceDelta(oldTransaction.Type, oldTransaction.Amount)); err != nil {
+			return err
+		}
+
+		return s.adjustBalance(sc, updatedTransaction["account_id"].(string), balanceDelta(updatedTransaction["type"].(string), storedAmount(updatedTransaction["amount"])))
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
 			s.logger.Info("Transaction not found", slog.String("id", req.Id))
 			return nil, nil
 		}
-		s.logger.Error("Error updating transaction", slog.Any("error", res.Err()))
-		return nil, res.Err()
-	}
-
-	var updatedTransaction bson.M
-	if err = res.Decode(&updatedTransaction); err != nil {
-		s.logger.Error("Error decoding updated transaction", slog.Any("error", err))
+		s.logger.Error("Error updating transaction", slog.Any("error", err))
 		return nil, err
 	}
 
-	return &pb.TransactionResponse{
-		Id:          updatedTransaction["_id"].(primitive.ObjectID).Hex(),
-		UserId:      updatedTransaction["user_id"].(string),
-		AccountId:   updatedTransaction["account_id"].(string),
-		CategoryId:  updatedTransaction["category_id"].(string),
-		Amount:      float32(updatedTransaction["amount"].(float64)),
-		Type:        updatedTransaction["type"].(string),
-		Description: updatedTransaction["description"].(string),
-		Date:        updatedTransaction["date"].(primitive.DateTime).Time().String(),
-		CreatedAt:   updatedTransaction["created_at"].(primitive.DateTime).Time().String(),
-		UpdatedAt:   updatedTransaction["updated_at"].(primitive.DateTime).Time().String(),
-	}, nil
+	return transactionResponse(updatedTransaction), nil
 }
 
 func (s *TransactionStorage) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.Empty, error) {
@@ -249,18 +474,401 @@ func (s *TransactionStorage) DeleteTransaction(ctx context.Context, req *pb.Dele
 		return nil, err
 	}
 
-	filter := bson.D{{Key: "_id", Value: objID}}
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
 	update := bson.D{
 		{Key: "$set", Value: bson.D{
 			{Key: "deleted_at", Value: time.Now()},
 		}},
 	}
 
-	_, err = transactionCollection.UpdateOne(ctx, filter, update)
-	if err != nil {
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		var deletedTransaction balanceEffect
+		if err := transactionCollection.FindOneAndUpdate(sc, filter, update).Decode(&deletedTransaction); err != nil {
+			return err
+		}
+
+		if err := s.adjustBalance(sc, deletedTransaction.AccountID, -balanceDelta(deletedTransaction.Type, deletedTransaction.Amount)); err != nil {
+			return err
+		}
+		if err := syncGoalContribution(sc, s.mongodb, bson.M{"_id": objID}); err != nil {
+			return err
+		}
+		if deletedTransaction.TransferID == "" {
+			return nil
+		}
+
+		siblingFilter := bson.D{
+			{Key: "transfer_id", Value: deletedTransaction.TransferID},
+			{Key: "_id", Value: bson.D{{Key: "$ne", Value: objID}}},
+			{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+		}
+
+		// A transfer leg whose sibling is already gone is still deleted on
+		// its own; only the primary document decides whether anything was
+		// found.
+		var sibling balanceEffect
+		if err := transactionCollection.FindOneAndUpdate(sc, siblingFilter, update).Decode(&sibling); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				s.logger.Warn("Transfer sibling not found", slog.String("transfer_id", deletedTransaction.TransferID))
+				return nil
+			}
+			return err
+		}
+
+		return s.adjustBalance(sc, sibling.AccountID, -balanceDelta(sibling.Type, sibling.Amount))
+	})
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
 		s.logger.Error("Error deleting transaction", slog.Any("error", err))
 		return nil, err
 	}
 
 	return &pb.Empty{}, nil
 }
+
+// balanceEffect holds the fields of a stored transaction that decide how it
+// moves its account balance.
+type balanceEffect struct {
+	UserID     string `bson:"user_id"`
+	AccountID  string `bson:"account_id"`
+	Amount     int64  `bson:"amount"`
+	Currency   string `bson:"currency"`
+	Type       string `bson:"type"`
+	TransferID string `bson:"transfer_id"`
+}
+
+// balanceDelta returns the signed change a transaction makes to its account
+// balance: income and incoming transfers add to it, expense and outgoing
+// transfers subtract from it.
+func balanceDelta(transactionType string, amount int64) int64 {
+	switch transactionType {
+	case "income", "transfer_in":
+		return amount
+	case "expense", "transfer_out":
+		return -amount
+	}
+	return 0
+}
+
+// adjustBalance moves the balance of the given account by delta. It must be
+// called with the session context of the transaction that caused the change.
+func (s *TransactionStorage) adjustBalance(sc mongo.SessionContext, accountID string, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	objID, err := primitive.ObjectIDFromHex(accountID)
+	if err != nil {
+		return fmt.Errorf("invalid account id %q: %w", accountID, err)
+	}
+
+	filter := bson.D{{Key: "_id", Value: objID}}
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "balance", Value: delta}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+	}
+
+	res, err := s.mongodb.Collection("accounts").UpdateOne(sc, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("account %s not found", accountID)
+	}
+
+	return nil
+}
+
+func (s *TransactionStorage) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.TransferResponse, error) {
+	s.logger.Info("CreateTransfer", slog.Any("req", req))
+
+	if req.FromAccountId == req.ToAccountId {
+		return nil, validation.Field("to_account_id", "must differ from from_account_id")
+	}
+
+	amount, err := money.FromProto(req.Amount)
+	if err != nil {
+		s.logger.Error("Invalid amount", slog.Any("error", err))
+		return nil, validation.Field("amount", "%v", err)
+	}
+	if amount <= 0 {
+		return nil, validation.Field("amount", "must be positive")
+	}
+
+	transactionCollection := s.mongodb.Collection("transactions")
+	created_at := time.Now()
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		s.logger.Error("Error parsing date", slog.Any("error", err))
+		return nil, validation.Field("date", "%q is not a date in the form YYYY-MM-DD", req.Date)
+	}
+
+	transferID := primitive.NewObjectID().Hex()
+	response := &pb.TransferResponse{TransferId: transferID}
+
+	err = withTransaction(ctx, s.mongodb, func(sc mongo.SessionContext) error {
+		fromCurrency, err := accountCurrency(sc, s.mongodb, req.UserId, req.FromAccountId, "from_account_id")
+		if err != nil {
+			return err
+		}
+		toCurrency, err := accountCurrency(sc, s.mongodb, req.UserId, req.ToAccountId, "to_account_id")
+		if err != nil {
+			return err
+		}
+		if _, err := money.Amount(req.Amount, fromCurrency); err != nil {
+			return validation.Field("amount", "%v", err)
+		}
+
+		rate := req.ExchangeRate
+		if !strings.EqualFold(fromCurrency, toCurrency) && req.ToAmount == nil && rate <= 0 {
+			stored, _, err := findRate(sc, s.mongodb, strings.ToUpper(fromCurrency), strings.ToUpper(toCurrency), date)
+			if err != nil {
+				return fmt.Errorf("%w; pass exchange_rate or to_amount", err)
+			}
+			rate = stored.Rate
+		}
+
+		toAmount, exchangeRate, err := transferAmounts(amount, req.ToAmount, rate, fromCurrency, toCurrency)
+		if err != nil {
+			return err
+		}
+		response.ExchangeRate = exchangeRate
+
+		legs := []struct {
+			accountID string
+			amount    int64
+			currency  string
+			kind      string
+		}{
+			{req.FromAccountId, amount, fromCurrency, "transfer_out"},
+			{req.ToAccountId, toAmount, toCurrency, "transfer_in"},
+		}
+
+		var responses []*pb.TransactionResponse
+		for _, leg := range legs {
+			legDoc := bson.D{
+				{Key: "user_id", Value: req.UserId},
+				{Key: "account_id", Value: leg.accountID},
+				{Key: "category_id", Value: ""},
+				{Key: "amount", Value: leg.amount},
+				{Key: "currency", Value: leg.currency},
+				{Key: "type", Value: leg.kind},
+				{Key: "description", Value: req.Description},
+				{Key: "date", Value: date},
+				{Key: "transfer_id", Value: transferID},
+				{Key: "created_at", Value: created_at},
+				{Key: "updated_at", Value: created_at},
+				{Key: "deleted_at", Value: nil},
+			}
+
+			res, err := transactionCollection.InsertOne(sc, legDoc)
+			if err != nil {
+				return err
+			}
+
+			if err := s.adjustBalance(sc, leg.accountID, balanceDelta(leg.kind, leg.amount)); err != nil {
+				return err
+			}
+
+			responses = append(responses, &pb.TransactionResponse{
+				Id:          res.InsertedID.(primitive.ObjectID).Hex(),
+				UserId:      req.UserId,
+				AccountId:   leg.accountID,
+				Amount:      money.ToProto(leg.amount, leg.currency),
+				Type:        leg.kind,
+				Description: req.Description,
+				Date:        req.Date,
+				TransferId:  transferID,
+				CreatedAt:   created_at.String(),
+			})
+		}
+
+		response.From, response.To = responses[0], responses[1]
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Error while creating transfer", slog.Any("error", err))
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// accountCurrency returns the currency of one of the user's live accounts,
+// reporting a missing account against field.
+func accountCurrency(ctx context.Context, db *mongo.Database, userID, accountID, field string) (string, error) {
+	objID, err := primitive.ObjectIDFromHex(accountID)
+	if err != nil {
+		return "", validation.Field(field, "%q is not a valid id", accountID)
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: objID},
+		{Key: "user_id", Value: userID},
+		{Key: "deleted_at", Value: bson.D{{Key: "$eq", Value: nil}}},
+	}
+
+	var account struct {
+		Currency string `bson:"currency"`
+	}
+	err = db.Collection("accounts").FindOne(ctx, filter).Decode(&account)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", validation.Field(field, "account %s not found", accountID)
+		}
+		return "", err
+	}
+
+	return account.Currency, nil
+}
+
+// transferAmounts works out how much arrives in the destination account. When
+// the two accounts hold different currencies it takes either the converted
+// amount or the exchange rate; CreateTransfer falls back to the stored rate
+// for the transfer date when the caller gives neither.
+func transferAmounts(amount int64, convertedAmount *common_pb.Money, rate float64, fromCurrency, toCurrency string) (int64, float64, error) {
+	switch {
+	case strings.EqualFold(fromCurrency, toCurrency):
+		return amount, 1, nil
+	case convertedAmount != nil:
+		toAmount, err := money.Amount(convertedAmount, toCurrency)
+		if err != nil {
+			return 0, 0, validation.Field("to_amount", "%v", err)
+		}
+		if toAmount <= 0 {
+			return 0, 0, validation.Field("to_amount", "must be positive")
+		}
+		return toAmount, float64(toAmount) / float64(amount), nil
+	case rate > 0:
+		return money.Convert(amount, rate), rate, nil
+	}
+
+	return 0, 0, validation.Field("exchange_rate", "exchange_rate or to_amount is required to transfer from %s to %s", fromCurrency, toCurrency)
+}
+
+// validateSplits checks that every split has a category and a positive amount
+// and that together they add up exactly to the transaction amount.
+func validateSplits(amount int64, splits []*pb.Split) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	var v validation.Violations
+	var total int64
+	for i, split := range splits {
+		v.Required(fmt.Sprintf("splits[%d].category_id", i), split.CategoryId)
+		splitAmount, err := money.FromProto(split.Amount)
+		switch {
+		case err != nil:
+			v.Add(fmt.Sprintf("splits[%d].amount", i), "%v", err)
+		case splitAmount <= 0:
+			v.Add(fmt.Sprintf("splits[%d].amount", i), "must be positive")
+		}
+		total += splitAmount
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	if total != amount {
+		return validation.Field("splits", "add up to %s but the transaction amount is %s", money.Format(total), money.Format(amount))
+	}
+
+	return nil
+}
+
+// checkTransactionCategories checks the category of a transaction and those
+// of its splits against the transaction's type.
+func checkTransactionCategories(ctx context.Context, db *mongo.Database, userID, transactionType, categoryID string, splits []*pb.Split) error {
+	if categoryID != "" {
+		if err := checkCategory(ctx, db, userID, categoryID, transactionType, "category_id"); err != nil {
+			return err
+		}
+	}
+	for i, split := range splits {
+		if err := checkCategory(ctx, db, userID, split.CategoryId, transactionType, fmt.Sprintf("splits[%d].category_id", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitDocs(splits []*pb.Split) (bson.A, error) {
+	docs := bson.A{}
+	for _, split := range splits {
+		amount, err := money.FromProto(split.Amount)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, bson.D{
+			{Key: "category_id", Value: split.CategoryId},
+			{Key: "amount", Value: amount},
+			{Key: "description", Value: split.Description},
+		})
+	}
+	return docs, nil
+}
+
+func transactionTags(transaction bson.M) []string {
+	docs, _ := transaction["tags"].(bson.A)
+	tags := make([]string, 0, len(docs))
+	for _, tag := range docs {
+		if tag, ok := tag.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func transactionSplits(transaction bson.M) []*pb.Split {
+	docs, _ := transaction["splits"].(bson.A)
+	currency := storedString(transaction["currency"])
+
+	var splits []*pb.Split
+	for _, doc := range docs {
+		split, ok := doc.(bson.M)
+		if !ok {
+			continue
+		}
+		splits = append(splits, &pb.Split{
+			CategoryId:  split["category_id"].(string),
+			Amount:      money.ToProto(storedAmount(split["amount"]), currency),
+			Description: split["description"].(string),
+		})
+	}
+	return splits
+}
+
+func transactionResponse(transaction bson.M) *pb.TransactionResponse {
+	response := &pb.TransactionResponse{
+		Id:          transaction["_id"].(primitive.ObjectID).Hex(),
+		UserId:      transaction["user_id"].(string),
+		AccountId:   transaction["account_id"].(string),
+		CategoryId:  transaction["category_id"].(string),
+		Amount:      money.ToProto(storedAmount(transaction["amount"]), storedString(transaction["currency"])),
+		Type:        transaction["type"].(string),
+		Description: transaction["description"].(string),
+		Date:        transaction["date"].(primitive.DateTime).Time().String(),
+		CreatedAt:   transaction["created_at"].(primitive.DateTime).Time().String(),
+	}
+	if updatedAt, ok := transaction["updated_at"].(primitive.DateTime); ok {
+		response.UpdatedAt = updatedAt.Time().String()
+	}
+	if transferID, ok := transaction["transfer_id"].(string); ok {
+		response.TransferId = transferID
+	}
+	if recurringID, ok := transaction["recurring_id"].(string); ok {
+		response.RecurringId = recurringID
+	}
+	if externalID, ok := transaction["external_id"].(string); ok {
+		response.ExternalId = externalID
+	}
+	response.GoalId = storedString(transaction["goal_id"])
+	response.Splits = transactionSplits(transaction)
+	response.Tags = transactionTags(transaction)
+
+	return response
+}
@@ -15,20 +15,26 @@ type StrorageI interface {
 	Account() repository.AccountI
 	Budget() repository.BudgetI
 	Category() repository.CategoryI
+	Currency() repository.CurrencyI
 	Goal() repository.GoalI
 	Notification() repository.NotificationI
 	Report() repository.ReportI
 	Transaction() repository.TransactionI
+	RecurringTransaction() repository.RecurringTransactionI
+	Rule() repository.RuleI
 }
 
 type Storage struct {
 	accountRepo      repository.AccountI
 	budgetRepo       repository.BudgetI
 	categoryRepo     repository.CategoryI
+	currencyRepo     repository.CurrencyI
 	goalRepo         repository.GoalI
 	notificationRepo repository.NotificationI
 	reportRepo       repository.ReportI
 	transactionRepo  repository.TransactionI
+	recurringRepo    repository.RecurringTransactionI
+	ruleRepo         repository.RuleI
 }
 
 func New(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) StrorageI {
@@ -36,10 +42,13 @@ func New(mongodb *mongo.Database, cfg *config.Config, logger *slog.Logger) Stror
 		accountRepo:      mdb.NewAccountStorage(mongodb, cfg, logger),
 		budgetRepo:       mdb.NewBudgetStorage(mongodb, cfg, logger),
 		categoryRepo:     mdb.NewCategoryStorage(mongodb, cfg, logger),
+		currencyRepo:     mdb.NewCurrencyStorage(mongodb, cfg, logger),
 		goalRepo:         mdb.NewGoalStorage(mongodb, cfg, logger),
 		notificationRepo: mdb.NewNotificationStorage(mongodb, cfg, logger),
 		reportRepo:       mdb.NewReportStorage(mongodb, cfg, logger),
 		transactionRepo:  mdb.NewTransactionStorage(mongodb, cfg, logger),
+		recurringRepo:    mdb.NewRecurringTransactionStorage(mongodb, cfg, logger),
+		ruleRepo:         mdb.NewRuleStorage(mongodb, cfg, logger),
 	}
 }
 
@@ -55,6 +64,10 @@ func (s *Storage) Category() repository.CategoryI {
 	return s.categoryRepo
 }
 
+func (s *Storage) Currency() repository.CurrencyI {
+	return s.currencyRepo
+}
+
 func (s *Storage) Goal() repository.GoalI {
 	return s.goalRepo
 }
@@ -70,3 +83,11 @@ func (s *Storage) Report() repository.ReportI {
 func (s *Storage) Transaction() repository.TransactionI {
 	return s.transactionRepo
 }
+
+func (s *Storage) RecurringTransaction() repository.RecurringTransactionI {
+	return s.recurringRepo
+}
+
+func (s *Storage) Rule() repository.RuleI {
+	return s.ruleRepo
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"budgeting-service/api"
+	jwttokens "budgeting-service/internal/items/jwt"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testJWTKey = "test-secret"
+
+func signedToken(t *testing.T, claims *jwttokens.Claims, method jwt.SigningMethod, key interface{}) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func callWithToken(authorization string) (*jwttokens.Claims, error) {
+	ctx := context.Background()
+	if authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+
+	var claims *jwttokens.Claims
+	_, err := api.UnaryAuthInterceptor(testJWTKey)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, _ = jwttokens.ClaimsFromContext(ctx)
+			return nil, nil
+		})
+	return claims, err
+}
+
+func TestAuthInterceptorAcceptsValidToken(t *testing.T) {
+	token, err := jwttokens.GenerateAccessToken("user-1", "alice", jwttokens.RoleUser, testJWTKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, authorization := range []string{"Bearer " + token, "bearer " + token, token} {
+		claims, err := callWithToken(authorization)
+		if err != nil {
+			t.Fatalf("%q: %v", authorization, err)
+		}
+		if claims == nil || claims.UserID != "user-1" || claims.Role != jwttokens.RoleUser {
+			t.Fatalf("claims = %+v", claims)
+		}
+	}
+}
+
+func TestAuthInterceptorRejectsBadTokens(t *testing.T) {
+	expired := signedToken(t, &jwttokens.Claims{
+		UserID:         "user-1",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+	}, jwt.SigningMethodHS256, []byte(testJWTKey))
+
+	wrongKey, err := jwttokens.GenerateAccessToken("user-1", "alice", jwttokens.RoleUser, "another-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unsigned := signedToken(t, &jwttokens.Claims{UserID: "user-1"}, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType)
+
+	service := signedToken(t, &jwttokens.Claims{UserID: "user-1", Role: jwttokens.RoleService}, jwt.SigningMethodHS256, []byte(testJWTKey))
+
+	tests := map[string]string{
+		"missing":      "",
+		"garbage":      "Bearer not-a-token",
+		"expired":      "Bearer " + expired,
+		"wrong key":    "Bearer " + wrongKey,
+		"alg none":     "Bearer " + unsigned,
+		"service role": "Bearer " + service,
+	}
+	for name, authorization := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := callWithToken(authorization)
+			if status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("got %v, want Unauthenticated", err)
+			}
+		})
+	}
+}
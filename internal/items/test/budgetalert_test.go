@@ -0,0 +1,70 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"budgeting-service/internal/items/budgetalert"
+)
+
+func TestBudgetAlertThresholds(t *testing.T) {
+	got, err := budgetalert.Thresholds([]int32{100, 50, 80, 50})
+	if err != nil || !reflect.DeepEqual(got, []int32{50, 80, 100}) {
+		t.Fatalf("Thresholds = %v, %v", got, err)
+	}
+	for _, bad := range [][]int32{{0}, {-10}, {1001}} {
+		if _, err := budgetalert.Thresholds(bad); err == nil {
+			t.Fatalf("Thresholds(%v): expected an error", bad)
+		}
+	}
+}
+
+func TestBudgetAlertCrossed(t *testing.T) {
+	thresholds := []int32{50, 80, 100}
+	tests := []struct {
+		spent int64
+		want  []string
+	}{
+		{24999, nil},
+		{25000, []string{"50"}},
+		{40001, []string{"50", "80"}},
+		{50000, []string{"50", "80", "100"}},
+		{50001, []string{"50", "80", "100", budgetalert.OverBudget}},
+	}
+	for _, tt := range tests {
+		got := budgetalert.Crossed(tt.spent, 50000, thresholds, true)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("Crossed(%d) = %v, want %v", tt.spent, got, tt.want)
+		}
+	}
+
+	if got := budgetalert.Crossed(60000, 50000, thresholds, false); len(got) != 3 {
+		t.Fatalf("over budget fired while disabled: %v", got)
+	}
+	if got := budgetalert.Crossed(100, 0, thresholds, true); got != nil {
+		t.Fatalf("zero budget = %v, want no alerts", got)
+	}
+}
+
+func TestBudgetAlertMessage(t *testing.T) {
+	alert := budgetalert.Alert{
+		CategoryName: "Groceries",
+		Threshold:    budgetalert.OverBudget,
+		Spent:        51200,
+		Limit:        50000,
+		Currency:     "USD",
+		PeriodStart:  date("2024-05-01"),
+		PeriodEnd:    date("2024-05-31"),
+	}
+	want := "You are 12.00 USD over your Groceries budget (512.00 USD of 500.00 USD) for 2024-05-01 to 2024-05-31."
+	if got := alert.Message(); got != want {
+		t.Fatalf("Message() = %q", got)
+	}
+
+	alert.Threshold = "80"
+	alert.Spent = 41000
+	want = "You have reached 80% of your Groceries budget (410.00 USD of 500.00 USD) for 2024-05-01 to 2024-05-31."
+	if got := alert.Message(); got != want {
+		t.Fatalf("Message() = %q", got)
+	}
+}
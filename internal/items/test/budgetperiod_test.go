@@ -0,0 +1,99 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"budgeting-service/internal/items/budgetperiod"
+)
+
+func TestBudgetPeriodNext(t *testing.T) {
+	tests := []struct {
+		name   string
+		period string
+		anchor string
+		end    string
+		want   [2]string
+	}{
+		{"weekly", budgetperiod.Weekly, "2024-01-01", "2024-01-07", [2]string{"2024-01-08", "2024-01-14"}},
+		{"biweekly", budgetperiod.Biweekly, "2024-01-01", "2024-01-14", [2]string{"2024-01-15", "2024-01-28"}},
+		{"monthly", budgetperiod.Monthly, "2024-01-01", "2024-01-31", [2]string{"2024-02-01", "2024-02-29"}},
+		{"monthly realigns a short first period", budgetperiod.Monthly, "2024-01-01", "2024-01-15", [2]string{"2024-01-16", "2024-01-31"}},
+		{"monthly on the 31st", budgetperiod.Monthly, "2024-01-31", "2024-02-28", [2]string{"2024-02-29", "2024-03-30"}},
+		{"quarterly", budgetperiod.Quarterly, "2024-01-01", "2024-03-31", [2]string{"2024-04-01", "2024-06-30"}},
+		{"yearly", budgetperiod.Yearly, "2024-01-01", "2024-12-31", [2]string{"2025-01-01", "2025-12-31"}},
+	}
+	for _, tt := range tests {
+		start, end, ok := budgetperiod.Next(tt.period, date(tt.anchor), date(tt.end))
+		got := [2]string{start.Format("2006-01-02"), end.Format("2006-01-02")}
+		if !ok || got != tt.want {
+			t.Fatalf("%s: Next = %v, %v, want %v", tt.name, got, ok, tt.want)
+		}
+	}
+
+	if _, _, ok := budgetperiod.Next("custom", date("2024-01-01"), date("2024-01-31")); ok {
+		t.Fatal("a custom period should not renew")
+	}
+}
+
+func TestBudgetPeriodCarry(t *testing.T) {
+	tests := []struct {
+		mode         string
+		limit, spent int64
+		want         int64
+	}{
+		{budgetperiod.RolloverNone, 50000, 30000, 0},
+		{budgetperiod.RolloverUnspent, 50000, 30000, 20000},
+		{budgetperiod.RolloverUnspent, 50000, 60000, 0},
+		{budgetperiod.RolloverOverspent, 50000, 30000, 0},
+		{budgetperiod.RolloverOverspent, 50000, 60000, -10000},
+		{budgetperiod.RolloverBoth, 50000, 30000, 20000},
+		{budgetperiod.RolloverBoth, 50000, 60000, -10000},
+	}
+	for _, tt := range tests {
+		if got := budgetperiod.Carry(tt.mode, tt.limit, tt.spent); got != tt.want {
+			t.Fatalf("Carry(%s, %d, %d) = %d, want %d", tt.mode, tt.limit, tt.spent, got, tt.want)
+		}
+	}
+}
+
+func TestBudgetPeriodRollover(t *testing.T) {
+	if mode, err := budgetperiod.Rollover("", "custom"); err != nil || mode != budgetperiod.RolloverNone {
+		t.Fatalf("Rollover(\"\") = %q, %v", mode, err)
+	}
+	if _, err := budgetperiod.Rollover(budgetperiod.RolloverUnspent, "custom"); err == nil {
+		t.Fatal("rollover on a non-recurring budget should be rejected")
+	}
+	if _, err := budgetperiod.Rollover("sideways", budgetperiod.Monthly); err == nil {
+		t.Fatal("unknown rollover mode should be rejected")
+	}
+}
+
+func TestBudgetPeriodMeasure(t *testing.T) {
+	start, end := date("2024-04-01"), date("2024-04-30")
+
+	pace := budgetperiod.Measure(60000, 20000, start, end, date("2024-04-10"))
+	want := budgetperiod.Pace{
+		DaysTotal:   30,
+		DaysElapsed: 10,
+		Remaining:   40000,
+		PercentUsed: 33.33,
+		DailyBurn:   2000,
+		Projected:   60000,
+	}
+	if pace != want {
+		t.Fatalf("Measure = %+v, want %+v", pace, want)
+	}
+
+	afternoon := date("2024-04-10").Add(13*time.Hour + 30*time.Minute)
+	if pace := budgetperiod.Measure(60000, 20000, start, end, afternoon); pace != want {
+		t.Fatalf("Measure in the afternoon = %+v, want %+v", pace, want)
+	}
+
+	if pace := budgetperiod.Measure(60000, 70000, start, end, date("2024-06-01")); pace.DaysElapsed != 30 || pace.Projected != 70000 || pace.Remaining != -10000 {
+		t.Fatalf("ended period = %+v", pace)
+	}
+	if pace := budgetperiod.Measure(60000, 0, start, end, date("2024-03-15")); pace.DaysElapsed != 0 || pace.DailyBurn != 0 || pace.Projected != 0 {
+		t.Fatalf("future period = %+v", pace)
+	}
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	pb "budgeting-service/genproto/category"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/service"
+	"budgeting-service/internal/items/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	aliceFood  = "65f1c0d2a1b2c3d4e5f60701"
+	aliceMeals = "65f1c0d2a1b2c3d4e5f60702"
+	bobFood    = "65f1c0d2a1b2c3d4e5f60703"
+)
+
+// fakeCategories is a CategoryI that records which retirements reach storage,
+// answering them with err if set, and whose categories are seeded.
+type fakeCategories struct {
+	repository.CategoryI
+	categories map[string]*pb.CategoryResponse
+	err        error
+	retired    []string
+	seeded     []string
+}
+
+func (f *fakeCategories) EnsureDefaultCategories(ctx context.Context, userID, locale string) error {
+	f.seeded = append(f.seeded, userID+"/"+locale)
+	return nil
+}
+
+func (f *fakeCategories) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.CategoriesResponse, error) {
+	return &pb.CategoriesResponse{}, nil
+}
+
+func (f *fakeCategories) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.CategoryTreeResponse, error) {
+	return &pb.CategoryTreeResponse{}, nil
+}
+
+func (f *fakeCategories) GetCategoryById(ctx context.Context, req *pb.GetCategoryByIdRequest) (*pb.CategoryResponse, error) {
+	return f.categories[req.Id], nil
+}
+
+func (f *fakeCategories) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.Empty, error) {
+	f.retired = append(f.retired, "delete "+req.Id)
+	return &pb.Empty{}, f.err
+}
+
+func (f *fakeCategories) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.CategoryResponse, error) {
+	f.retired = append(f.retired, "merge "+req.SourceId)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.categories[req.TargetId], nil
+}
+
+func (f *fakeCategories) ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.CategoryResponse, error) {
+	f.retired = append(f.retired, "archive "+req.Id)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return nil, nil
+}
+
+func newCategoryService() (*service.CategoryService, *fakeCategories) {
+	storage := &fakeCategories{categories: map[string]*pb.CategoryResponse{
+		aliceFood:  {Id: aliceFood, UserId: "alice", Type: "expense"},
+		aliceMeals: {Id: aliceMeals, UserId: "alice", Type: "expense"},
+		bobFood:    {Id: bobFood, UserId: "bob", Type: "expense"},
+	}}
+	return service.NewCategoryService(storage, slog.New(slog.NewTextHandler(io.Discard, nil))), storage
+}
+
+func TestCategoryMergeOwnership(t *testing.T) {
+	categories, storage := newCategoryService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	if _, err := categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: aliceFood, TargetId: bobFood}); status.Code(err) != codes.NotFound {
+		t.Fatalf("merging into another user's category: got %v, want NotFound", err)
+	}
+	if _, err := categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: bobFood, TargetId: aliceFood}); status.Code(err) != codes.NotFound {
+		t.Fatalf("merging another user's category: got %v, want NotFound", err)
+	}
+	if _, err := categories.ArchiveCategory(alice, &pb.ArchiveCategoryRequest{Id: bobFood}); status.Code(err) != codes.NotFound {
+		t.Fatalf("archiving another user's category: got %v, want NotFound", err)
+	}
+	if _, err := categories.DeleteCategory(alice, &pb.DeleteCategoryRequest{Id: bobFood}); status.Code(err) != codes.NotFound {
+		t.Fatalf("deleting another user's category: got %v, want NotFound", err)
+	}
+	if len(storage.retired) != 0 {
+		t.Fatalf("refused requests reached storage: %v", storage.retired)
+	}
+
+	admin := as("root", jwttokens.RoleAdmin)
+	if _, err := categories.MergeCategories(admin, &pb.MergeCategoriesRequest{SourceId: aliceFood, TargetId: aliceMeals}); status.Code(err) != codes.NotFound {
+		t.Fatalf("admin merging a user's categories: got %v, want NotFound", err)
+	}
+
+	merged, err := categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: aliceFood, TargetId: aliceMeals})
+	if err != nil || merged.Id != aliceMeals {
+		t.Fatalf("own merge: %v, returned %v", err, merged)
+	}
+}
+
+func TestCategoryMergeValidation(t *testing.T) {
+	categories, storage := newCategoryService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	_, err := categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: aliceFood, TargetId: aliceFood})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[target_id]" {
+		t.Fatalf("merging into itself: violations on %s, want [target_id]", got)
+	}
+	_, err = categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: "food"})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[source_id target_id]" {
+		t.Fatalf("bad ids: violations on %s, want [source_id target_id]", got)
+	}
+	_, err = categories.ArchiveCategory(alice, &pb.ArchiveCategoryRequest{Id: aliceFood, ReassignTo: "meals"})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[reassign_to]" {
+		t.Fatalf("bad reassign_to: violations on %s, want [reassign_to]", got)
+	}
+	if len(storage.retired) != 0 {
+		t.Fatalf("invalid requests reached storage: %v", storage.retired)
+	}
+}
+
+func TestCategoryRetireStorageRefusals(t *testing.T) {
+	categories, storage := newCategoryService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	// What storage refuses reaches the caller as is: a category still in use
+	// as a failed precondition, a target that is archived or of another type
+	// as a field violation.
+	storage.err = status.Error(codes.FailedPrecondition, "the category is still in use")
+	_, err := categories.DeleteCategory(alice, &pb.DeleteCategoryRequest{Id: aliceFood})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("deleting a category in use: got %v, want FailedPrecondition", err)
+	}
+
+	storage.err = validation.Field("target_id", "category %s is archived", aliceMeals)
+	_, err = categories.MergeCategories(alice, &pb.MergeCategoriesRequest{SourceId: aliceFood, TargetId: aliceMeals})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[target_id]" {
+		t.Fatalf("merging into an archived category: violations on %s, want [target_id]", got)
+	}
+
+	storage.err = nil
+	if _, err := categories.ArchiveCategory(alice, &pb.ArchiveCategoryRequest{Id: aliceFood}); status.Code(err) != codes.NotFound {
+		t.Fatalf("archiving a category storage no longer has: got %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	category_pb "budgeting-service/genproto/category"
+	"budgeting-service/internal/items/categoryseed"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+)
+
+func TestCategorySeedDefaults(t *testing.T) {
+	set := categoryseed.Default()
+	if set.Version < 1 || len(set.Categories) == 0 {
+		t.Fatalf("Default() = version %d with %d categories", set.Version, len(set.Categories))
+	}
+
+	for _, template := range set.Categories {
+		for _, locale := range []string{"en", "ru", "uz"} {
+			if template.Names[locale] == "" {
+				t.Fatalf("template %q has no %s name", template.Key, locale)
+			}
+		}
+	}
+}
+
+func TestCategorySeedParse(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		err  string
+	}{
+		{"valid", `{"version": 2, "categories": [
+			{"key": "food", "type": "expense", "since": 1, "names": {"en": "Food"}},
+			{"key": "groceries", "parent": "food", "type": "expense", "since": 2, "names": {"en": "Groceries"}}]}`, ""},
+		{"no version", `{"categories": []}`, "invalid version"},
+		{"duplicate key", `{"version": 1, "categories": [
+			{"key": "food", "type": "expense", "since": 1, "names": {"en": "Food"}},
+			{"key": "food", "type": "expense", "since": 1, "names": {"en": "Food"}}]}`, "duplicate key"},
+		{"bad type", `{"version": 1, "categories": [
+			{"key": "food", "type": "spending", "since": 1, "names": {"en": "Food"}}]}`, "invalid type"},
+		{"since after version", `{"version": 1, "categories": [
+			{"key": "food", "type": "expense", "since": 2, "names": {"en": "Food"}}]}`, "since must be"},
+		{"no default name", `{"version": 1, "categories": [
+			{"key": "food", "type": "expense", "since": 1, "names": {"ru": "Еда"}}]}`, "en name is required"},
+		{"parent of another type", `{"version": 1, "categories": [
+			{"key": "salary", "type": "income", "since": 1, "names": {"en": "Salary"}},
+			{"key": "bonus", "parent": "salary", "type": "expense", "since": 1, "names": {"en": "Bonus"}}]}`, "parent"},
+		{"parent later in the set", `{"version": 1, "categories": [
+			{"key": "groceries", "parent": "food", "type": "expense", "since": 1, "names": {"en": "Groceries"}},
+			{"key": "food", "type": "expense", "since": 1, "names": {"en": "Food"}}]}`, "parent"},
+	}
+	for _, tt := range tests {
+		_, err := categoryseed.Parse(strings.NewReader(tt.json))
+		if tt.err == "" && err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Fatalf("%s: error = %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestCategorySeedSinceAndName(t *testing.T) {
+	set, err := categoryseed.Parse(strings.NewReader(`{"version": 2, "categories": [
+		{"key": "food", "type": "expense", "since": 1, "names": {"en": "Food", "ru": "Еда"}},
+		{"key": "pets", "type": "expense", "since": 2, "names": {"en": "Pets"}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := set.Since(1); len(got) != 1 || got[0].Key != "pets" {
+		t.Fatalf("Since(1) = %v, want pets only", got)
+	}
+	if got := set.Since(2); len(got) != 0 {
+		t.Fatalf("Since(2) = %v, want none", got)
+	}
+
+	food := set.Categories[0]
+	for locale, want := range map[string]string{"ru": "Еда", "ru_RU": "Еда", "uz": "Food", "": "Food"} {
+		if got := food.Name(locale); got != want {
+			t.Fatalf("Name(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestCategorySeedOnOwnReadsOnly(t *testing.T) {
+	categories, storage := newCategoryService()
+
+	if _, err := categories.GetCategories(as("alice", jwttokens.RoleUser), &category_pb.GetCategoriesRequest{Locale: "ru"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := categories.GetCategoryTree(as("alice", jwttokens.RoleUser), &category_pb.GetCategoryTreeRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := rbac.WithAnyUser(as("root", jwttokens.RoleAdmin))
+	if _, err := categories.GetCategories(admin, &category_pb.GetCategoriesRequest{UserId: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := categories.GetCategoryTree(admin, &category_pb.GetCategoryTreeRequest{UserId: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Join(storage.seeded, ","); got != "alice/ru,alice/" {
+		t.Fatalf("seeded %q, want only alice's own reads in her locale", got)
+	}
+}
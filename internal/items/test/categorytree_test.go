@@ -0,0 +1,94 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"budgeting-service/internal/items/categorytree"
+)
+
+func newCategoryTree() *categorytree.Tree {
+	return categorytree.New(map[string]string{
+		"food":      "",
+		"groceries": "food",
+		"dining":    "food",
+		"coffee":    "dining",
+		"rent":      "",
+		"stray":     "deleted",
+	})
+}
+
+func TestCategoryTreeChildren(t *testing.T) {
+	tree := newCategoryTree()
+
+	if got, want := tree.Children(""), []string{"food", "rent", "stray"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Children(\"\") = %v, want %v", got, want)
+	}
+	if got, want := tree.Children("food"), []string{"dining", "groceries"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Children(food) = %v, want %v", got, want)
+	}
+	if got := tree.Children("rent"); len(got) != 0 {
+		t.Fatalf("Children(rent) = %v, want none", got)
+	}
+}
+
+func TestCategoryTreeDescendantsAndAncestors(t *testing.T) {
+	tree := newCategoryTree()
+
+	if got, want := tree.Descendants("food"), []string{"food", "dining", "groceries", "coffee"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Descendants(food) = %v, want %v", got, want)
+	}
+	if got, want := tree.Ancestors("coffee"), []string{"coffee", "dining", "food"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Ancestors(coffee) = %v, want %v", got, want)
+	}
+	if got, want := tree.Ancestors("stray"), []string{"stray"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Ancestors(stray) = %v, want %v", got, want)
+	}
+	if got := tree.Root("coffee"); got != "food" {
+		t.Fatalf("Root(coffee) = %q, want food", got)
+	}
+	if got := tree.Root("unknown"); got != "unknown" {
+		t.Fatalf("Root(unknown) = %q, want unknown", got)
+	}
+}
+
+func TestCategoryTreeCheckMove(t *testing.T) {
+	tree := newCategoryTree()
+
+	tests := []struct {
+		id, parent string
+		ok         bool
+	}{
+		{"coffee", "", true},
+		{"coffee", "rent", true},
+		{"dining", "groceries", true},
+		{"food", "food", false},
+		{"food", "coffee", false},
+		{"dining", "coffee", false},
+	}
+	for _, tt := range tests {
+		if err := tree.CheckMove(tt.id, tt.parent); (err == nil) != tt.ok {
+			t.Fatalf("CheckMove(%s, %s) = %v, want ok %v", tt.id, tt.parent, err, tt.ok)
+		}
+	}
+}
+
+func TestCategoryTreeCheckMerge(t *testing.T) {
+	tree := newCategoryTree()
+
+	tests := []struct {
+		source, target string
+		ok             bool
+	}{
+		{"dining", "groceries", true},
+		{"coffee", "food", true},
+		{"food", "food", false},
+		{"food", "coffee", false},
+		{"dining", "coffee", false},
+	}
+	for _, tt := range tests {
+		if err := tree.CheckMerge(tt.source, tt.target); (err == nil) != tt.ok {
+			t.Fatalf("CheckMerge(%s, %s) = %v, want ok %v", tt.source, tt.target, err, tt.ok)
+		}
+	}
+}
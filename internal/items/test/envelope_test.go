@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	budget_pb "budgeting-service/genproto/budget"
+	common_pb "budgeting-service/genproto/common"
+	"budgeting-service/internal/items/envelope"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/service"
+)
+
+func TestEnvelopeMonth(t *testing.T) {
+	now := time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)
+	if got, err := envelope.Month("", now); err != nil || !got.Equal(date("2024-03-01")) {
+		t.Errorf("default month = %v, %v, want 2024-03-01", got, err)
+	}
+	if got, err := envelope.Month("2023-12", now); err != nil || !got.Equal(date("2023-12-01")) {
+		t.Errorf("Month(2023-12) = %v, %v", got, err)
+	}
+	for _, month := range []string{"2024-13", "2024-03-01", "March"} {
+		_, err := envelope.Month(month, now)
+		if got := fmt.Sprint(fieldViolations(t, err)); got != "[month]" {
+			t.Errorf("Month(%q) violations on %s, want [month]", month, got)
+		}
+	}
+}
+
+func TestEnvelopeSummarize(t *testing.T) {
+	assignments := []envelope.Assignment{
+		{CategoryID: "food", Month: date("2024-01-01"), Amount: 30000},
+		{CategoryID: "rent", Month: date("2024-02-01"), Amount: 100000},
+		{CategoryID: "food", Month: date("2024-02-01"), Amount: 25000},
+		{CategoryID: "food", Month: date("2024-03-01"), Amount: -5000},
+		{CategoryID: "fun", Month: date("2024-04-01"), Amount: 9000},
+	}
+
+	summary := envelope.Summarize(assignments, date("2024-03-01"))
+	if !summary.FirstMonth.Equal(date("2024-01-01")) {
+		t.Errorf("first month %v, want 2024-01-01", summary.FirstMonth)
+	}
+	if summary.Assigned != -5000 || summary.AssignedTotal != 150000 {
+		t.Errorf("assigned %d of %d, want -5000 of 150000", summary.Assigned, summary.AssignedTotal)
+	}
+	if got := summary.ToBeBudgeted(180000); got != 30000 {
+		t.Errorf("to be budgeted %d, want 30000", got)
+	}
+
+	want := []envelope.Envelope{
+		{CategoryID: "food", FirstMonth: date("2024-01-01"), Assigned: -5000, AssignedTotal: 50000},
+		{CategoryID: "rent", FirstMonth: date("2024-02-01"), AssignedTotal: 100000},
+	}
+	if len(summary.Envelopes) != len(want) {
+		t.Fatalf("envelopes %+v, want %+v", summary.Envelopes, want)
+	}
+	for i := range want {
+		if summary.Envelopes[i] != want[i] {
+			t.Errorf("envelope %d = %+v, want %+v", i, summary.Envelopes[i], want[i])
+		}
+	}
+	if got := summary.Envelopes[0].Available(62000); got != -12000 {
+		t.Errorf("overspent envelope available %d, want -12000", got)
+	}
+
+	if empty := envelope.Summarize(nil, date("2024-03-01")); !empty.FirstMonth.Equal(date("2024-03-01")) || len(empty.Envelopes) != 0 {
+		t.Errorf("no assignments = %+v", empty)
+	}
+}
+
+func TestEnvelopeCheckMove(t *testing.T) {
+	if err := envelope.CheckMove(5000, 5000, "USD"); err != nil {
+		t.Errorf("moving everything available: %v", err)
+	}
+	if got := fmt.Sprint(fieldViolations(t, envelope.CheckMove(5000, 5001, "USD"))); got != "[amount]" {
+		t.Errorf("moving more than available: violations on %s, want [amount]", got)
+	}
+	if got := fmt.Sprint(fieldViolations(t, envelope.CheckMove(-100, 1, "USD"))); got != "[amount]" {
+		t.Errorf("moving out of an overspent envelope: violations on %s, want [amount]", got)
+	}
+}
+
+// fakeEnvelopes is a BudgetI that records the moves reaching storage.
+type fakeEnvelopes struct {
+	repository.BudgetI
+	moved *budget_pb.MoveBetweenEnvelopesRequest
+}
+
+func (f *fakeEnvelopes) MoveBetweenEnvelopes(ctx context.Context, req *budget_pb.MoveBetweenEnvelopesRequest) (*budget_pb.EnvelopeSummaryResponse, error) {
+	f.moved = req
+	return &budget_pb.EnvelopeSummaryResponse{}, nil
+}
+
+func TestEnvelopeMoveValidation(t *testing.T) {
+	storage := &fakeEnvelopes{}
+	budgets := service.NewBudgetService(storage, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	alice := as("alice", jwttokens.RoleUser)
+	food, rent := "65f1c0d2a1b2c3d4e5f60718", "65f1c0d2a1b2c3d4e5f60719"
+
+	_, err := budgets.MoveBetweenEnvelopes(alice, &budget_pb.MoveBetweenEnvelopesRequest{
+		FromCategoryId: food, ToCategoryId: food, Amount: &common_pb.Money{Units: -5},
+	})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[to_category_id amount]" {
+		t.Fatalf("violations on %s, want [to_category_id amount]", got)
+	}
+	if storage.moved != nil {
+		t.Fatalf("invalid move reached storage: %v", storage.moved)
+	}
+
+	_, err = budgets.MoveBetweenEnvelopes(alice, &budget_pb.MoveBetweenEnvelopesRequest{
+		FromCategoryId: food, ToCategoryId: rent, Amount: &common_pb.Money{Units: 20},
+	})
+	if err != nil || storage.moved == nil || storage.moved.UserId != "alice" {
+		t.Fatalf("valid move: %v, reached storage as %v", err, storage.moved)
+	}
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"budgeting-service/internal/items/exchange"
+)
+
+func TestParseRates(t *testing.T) {
+	rates, err := exchange.ParseRates(strings.NewReader("date,base,quote,rate\n" +
+		"# central bank fixing\n" +
+		"2024-01-31, usd, UZS, 12450.5\n" +
+		"2024-02-01,EUR,USD,1.0815\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("got %d rates, want 2", len(rates))
+	}
+	if r := rates[0]; r.Base != "USD" || r.Quote != "UZS" || r.Rate != 12450.5 || r.Date.Format("2006-01-02") != "2024-01-31" {
+		t.Fatalf("rate 1 = %+v", r)
+	}
+
+	for _, bad := range []string{
+		"2024-01-31,USD,USD,1\n",
+		"2024-01-31,USD,UZS,0\n",
+		"2024-01-31,DOLLAR,UZS,1\n",
+		"31.01.2024,USD,UZS,1\n",
+	} {
+		if _, err := exchange.ParseRates(strings.NewReader(bad)); err == nil {
+			t.Fatalf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestConverterCachesRatePerDay(t *testing.T) {
+	calls := 0
+	converter := exchange.NewConverter("UZS", func(ctx context.Context, from, to string, date time.Time) (float64, error) {
+		calls++
+		if from != "USD" || to != "UZS" {
+			t.Fatalf("lookup %s -> %s", from, to)
+		}
+		return 12500, nil
+	})
+
+	ctx := context.Background()
+	day := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		got, err := converter.Convert(ctx, 1001, "usd", day)
+		if err != nil || got != 12512500 {
+			t.Fatalf("Convert = %d, %v", got, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("lookup called %d times, want 1", calls)
+	}
+
+	if got, _ := converter.Convert(ctx, 700, "UZS", day); got != 700 {
+		t.Fatalf("same currency converted to %d", got)
+	}
+	if got, _ := converter.Convert(ctx, 700, "", day); got != 700 {
+		t.Fatalf("amount without currency converted to %d", got)
+	}
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"budgeting-service/internal/items/exporter"
+	"budgeting-service/internal/items/importer"
+)
+
+func exportSample() []*exporter.Transaction {
+	return []*exporter.Transaction{
+		{
+			ID: "t1", Date: date("2024-01-05"), AccountID: "a1", AccountName: "Checking", Currency: "USD",
+			CategoryID: "c1", CategoryName: "Salary", Type: "income", Amount: 250000, Description: "Payroll",
+		},
+		{
+			ID: "t2", Date: date("2024-01-06"), AccountID: "a1", AccountName: "Checking", Currency: "USD",
+			Type: "expense", Amount: 8000, Description: "Supermarket, \"weekly\" & more",
+			Splits: []exporter.Split{
+				{CategoryID: "c2", CategoryName: "Groceries", Amount: 6000},
+				{CategoryID: "c3", CategoryName: "Household", Amount: 2000},
+			},
+		},
+		{
+			ID: "t3", Date: date("2024-01-07"), AccountID: "a2", AccountName: "Savings", Currency: "EUR",
+			Type: "transfer_in", Amount: 10005, TransferID: "x1", ExternalID: "BANK-9",
+		},
+	}
+}
+
+func export(t *testing.T, format string) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	writer, err := exporter.NewWriter(format, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, transaction := range exportSample() {
+		if err := writer.Write(transaction); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestExportCSV(t *testing.T) {
+	got := export(t, "csv")
+	want := "id,date,account,currency,type,category,description,amount,transfer_id\n" +
+		"t1,2024-01-05,Checking,USD,income,Salary,Payroll,2500.00,\n" +
+		"t2,2024-01-06,Checking,USD,expense,Groceries,\"Supermarket, \"\"weekly\"\" & more\",-60.00,\n" +
+		"t2,2024-01-06,Checking,USD,expense,Household,\"Supermarket, \"\"weekly\"\" & more\",-20.00,\n" +
+		"t3,2024-01-07,Savings,EUR,transfer_in,,,100.05,x1\n"
+	if got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportNDJSON(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(export(t, "ndjson")), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+
+	var second map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatal(err)
+	}
+	if second["date"] != "2024-01-06" || second["account_name"] != "Checking" || second["currency"] != "USD" {
+		t.Fatalf("line 2 = %s", lines[1])
+	}
+	if splits, ok := second["splits"].([]interface{}); !ok || len(splits) != 2 {
+		t.Fatalf("line 2 splits = %v", second["splits"])
+	}
+	if !strings.Contains(lines[2], `"amount":100.05`) {
+		t.Fatalf("line 3 = %s", lines[2])
+	}
+}
+
+func TestExportOFXRoundTrip(t *testing.T) {
+	out := export(t, "ofx")
+	if strings.Count(out, "<STMTRS>") != 2 {
+		t.Fatalf("expected one statement per account:\n%s", out)
+	}
+
+	statement, err := importer.ParseOFX([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statement.Rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(statement.Rows))
+	}
+
+	groceries := statement.Rows[1]
+	if groceries.Err != nil || groceries.ExternalID != "t2" || groceries.Type != importer.Expense || groceries.Amount != 8000 ||
+		groceries.Description != "Supermarket, \"weekly\" & more - Groceries, Household" {
+		t.Fatalf("row 2 = %+v", groceries)
+	}
+	if transfer := statement.Rows[2]; transfer.ExternalID != "BANK-9" || transfer.Type != importer.Income {
+		t.Fatalf("row 3 = %+v", transfer)
+	}
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	if _, err := exporter.NewWriter("xlsx", &bytes.Buffer{}); err == nil {
+		t.Fatal("expected an error for an unsupported format")
+	}
+}
@@ -0,0 +1,229 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"budgeting-service/internal/items/goaltrack"
+)
+
+func TestGoalTracking(t *testing.T) {
+	tests := []struct {
+		mode     string
+		accounts []string
+		want     string
+		wantErr  bool
+	}{
+		{"", nil, goaltrack.Manual, false},
+		{"", []string{"a"}, goaltrack.Accounts, false},
+		{goaltrack.Contributions, nil, goaltrack.Contributions, false},
+		{goaltrack.Accounts, []string{"a", "b"}, goaltrack.Accounts, false},
+		{goaltrack.Accounts, nil, "", true},
+		{"balance", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := goaltrack.Tracking(tt.mode, tt.accounts)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Tracking(%q, %v) = %q, %v; want %q, error %v", tt.mode, tt.accounts, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestGoalStatus(t *testing.T) {
+	deadline := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		current int64
+		now     time.Time
+		status  string
+		want    string
+	}{
+		{"under way", 50000, deadline.Add(-time.Hour), "", goaltrack.InProgress},
+		{"on the deadline day", 50000, deadline.Add(23 * time.Hour), goaltrack.InProgress, goaltrack.InProgress},
+		{"reached", 100000, deadline.Add(-time.Hour), goaltrack.InProgress, goaltrack.Achieved},
+		{"reached late", 120000, deadline.AddDate(0, 1, 0), goaltrack.Missed, goaltrack.Achieved},
+		{"deadline passed", 99999, deadline.AddDate(0, 0, 1), goaltrack.InProgress, goaltrack.Missed},
+		{"fell back", 90000, deadline.Add(-time.Hour), goaltrack.Achieved, goaltrack.InProgress},
+		{"paused", 100000, deadline.AddDate(0, 0, 1), "paused", "paused"},
+	}
+
+	for _, tt := range tests {
+		if got := goaltrack.Status(tt.current, 100000, deadline, tt.now, tt.status); got != tt.want {
+			t.Errorf("%s: Status = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if got := goaltrack.Status(0, 100000, time.Time{}, deadline, ""); got != goaltrack.InProgress {
+		t.Errorf("no deadline: Status = %q, want %q", got, goaltrack.InProgress)
+	}
+}
+
+func TestGoalProject(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	deadline := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	// 1000.00 left over 366 days; 300.00 added over the last 90 days.
+	p := goaltrack.Project(200000, 100000, deadline, now, 30000, 90)
+	if p.Remaining != 100000 {
+		t.Errorf("Remaining = %d, want 100000", p.Remaining)
+	}
+	if p.MonthlyRequired != 8317 || p.WeeklyRequired != 1913 {
+		t.Errorf("required = %d a month, %d a week; want 8317, 1913", p.MonthlyRequired, p.WeeklyRequired)
+	}
+	if p.MonthlyRate != 10146 {
+		t.Errorf("MonthlyRate = %d, want 10146", p.MonthlyRate)
+	}
+	if want := time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC); !p.Completion.Equal(want) {
+		t.Errorf("Completion = %s, want %s", p.Completion, want)
+	}
+	if p.Pace != goaltrack.OnTrack {
+		t.Errorf("Pace = %q, want %q", p.Pace, goaltrack.OnTrack)
+	}
+
+	tests := []struct {
+		name        string
+		contributed int64
+		window      int
+		deadline    time.Time
+		want        string
+	}{
+		{"a little slow", 20000, 90, deadline, goaltrack.AtRisk},
+		{"far too slow", 5000, 90, deadline, goaltrack.Behind},
+		{"shrinking", -5000, 90, deadline, goaltrack.Behind},
+		{"no history yet", 0, 0, deadline, goaltrack.OnTrack},
+		{"deadline passed", 30000, 90, now.AddDate(0, 0, -1), goaltrack.Behind},
+	}
+	for _, tt := range tests {
+		if got := goaltrack.Project(200000, 100000, tt.deadline, now, tt.contributed, tt.window).Pace; got != tt.want {
+			t.Errorf("%s: Pace = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	done := goaltrack.Project(100000, 120000, deadline, now, 0, 0)
+	if done.Remaining != 0 || done.MonthlyRequired != 0 || done.Pace != goaltrack.OnTrack {
+		t.Errorf("reached goal: %+v", done)
+	}
+
+	stalled := goaltrack.Project(200000, 100000, deadline, now, 0, 90)
+	if !stalled.Completion.IsZero() {
+		t.Errorf("stalled goal completes on %s", stalled.Completion)
+	}
+}
+
+func TestGoalAlertMessage(t *testing.T) {
+	alert := goaltrack.Alert{
+		Name:     "Holiday",
+		Current:  50000,
+		Target:   200000,
+		Deadline: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		Currency: "USD",
+	}
+
+	tests := []struct {
+		kind      string
+		milestone goaltrack.Milestone
+		want      string
+	}{
+		{goaltrack.AlertAchieved, goaltrack.Milestone{}, "Congratulations, you have reached your goal Holiday!"},
+		{goaltrack.AlertMilestone, goaltrack.Milestone{Percent: 25}, "Your goal Holiday has reached its 25% milestone (500.00 USD of 2000.00 USD)."},
+		{goaltrack.AlertMilestone, goaltrack.Milestone{Amount: 40000}, "Your goal Holiday has reached its 400.00 USD milestone (500.00 USD of 2000.00 USD)."},
+		{goaltrack.AlertAtRisk, goaltrack.Milestone{}, "Your goal Holiday is at risk of missing its 2024-12-31 deadline: it needs 83.17 USD a month but has been growing by 67.64 USD a month."},
+	}
+
+	for _, tt := range tests {
+		alert.Kind = tt.kind
+		alert.Milestone = tt.milestone
+		alert.Projection = goaltrack.Projection{MonthlyRequired: 8317, MonthlyRate: 6764}
+		if got := alert.Message(); got != tt.want {
+			t.Errorf("%s: Message = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestGoalMilestones(t *testing.T) {
+	milestones, err := goaltrack.Milestones([]goaltrack.Milestone{
+		{ID: "75", Percent: 75},
+		{ID: "deposit", Amount: 30000},
+		{ID: "25", Percent: 25},
+	}, 100001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	for _, m := range milestones {
+		order = append(order, m.ID)
+	}
+	if got := strings.Join(order, ","); got != "25,deposit,75" {
+		t.Errorf("order = %s, want 25,deposit,75", got)
+	}
+	if got := milestones[0].Threshold(100001); got != 25001 {
+		t.Errorf("25%% of 1000.01 = %d, want 25001", got)
+	}
+
+	milestones[0].ReachedAt = time.Now()
+	if got := goaltrack.Reached(milestones, 100001, 30000); len(got) != 1 || got[0] != 1 {
+		t.Errorf("Reached = %v, want [1]", got)
+	}
+	if got := goaltrack.Reached(milestones, 100001, 80000); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Reached across two milestones = %v, want [1 2]", got)
+	}
+	if got := goaltrack.Reached(milestones, 100001, 25000); len(got) != 0 {
+		t.Errorf("Reached below every open milestone = %v", got)
+	}
+
+	invalid := [][]goaltrack.Milestone{
+		{{}},
+		{{Percent: 50, Amount: 100}},
+		{{Percent: 101}},
+		{{Amount: -1}},
+		{{Amount: 200000}},
+	}
+	for _, ms := range invalid {
+		if _, err := goaltrack.Milestones(ms, 100000); err == nil {
+			t.Errorf("Milestones(%+v) accepted", ms)
+		}
+	}
+}
+
+func TestGoalContributionSigned(t *testing.T) {
+	tests := []struct {
+		kind    string
+		amount  int64
+		want    int64
+		wantErr bool
+	}{
+		{"", 500, 500, false},
+		{goaltrack.Deposit, 500, 500, false},
+		{goaltrack.Withdrawal, 500, -500, false},
+		{goaltrack.Withdrawal, 0, 0, true},
+		{goaltrack.Deposit, -500, 0, true},
+		{"refund", 500, 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := goaltrack.Signed(tt.kind, tt.amount)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Signed(%q, %d) = %d, %v; want %d, error %v", tt.kind, tt.amount, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestGoalMilestoneAlerts(t *testing.T) {
+	goal := goaltrack.Alert{Name: "Holiday", Current: 160000, Target: 200000, Currency: "USD"}
+	alerts := goal.MilestoneAlerts([]goaltrack.Milestone{{ID: "25", Percent: 25}, {ID: "50", Percent: 50}})
+	if len(alerts) != 2 {
+		t.Fatalf("%d alerts, want one per milestone", len(alerts))
+	}
+	for i, want := range []string{"25", "50"} {
+		if alerts[i].Kind != goaltrack.AlertMilestone || alerts[i].Milestone.ID != want {
+			t.Errorf("alert %d = %s %s, want milestone %s", i, alerts[i].Kind, alerts[i].Milestone.ID, want)
+		}
+	}
+	if alerts[0].Message() == alerts[1].Message() {
+		t.Errorf("both alerts say %q", alerts[0].Message())
+	}
+}
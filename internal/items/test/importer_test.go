@@ -0,0 +1,109 @@
+package test
+
+import (
+	"testing"
+
+	"budgeting-service/internal/items/importer"
+)
+
+func TestParseCSVSignedAmount(t *testing.T) {
+	data := []byte("Date;Details;Amount\n" +
+		"31.01.2024;Salary;2 500,00\n" +
+		"01.02.2024;Groceries;-45,10\n" +
+		"\n" +
+		"02.02.2024;Refund;(3,00)\n" +
+		"2024-02-03;Broken;1,00\n")
+
+	rows, err := importer.ParseCSV(data, importer.CSVMapping{
+		Delimiter:         ";",
+		HasHeader:         true,
+		DateColumn:        "date",
+		DateFormat:        "DD.MM.YYYY",
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Details",
+		DecimalSeparator:  ",",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(rows))
+	}
+
+	want := []struct {
+		line   int
+		date   string
+		amount int64
+		kind   string
+	}{
+		{2, "2024-01-31", 250000, importer.Income},
+		{3, "2024-02-01", 4510, importer.Expense},
+		{5, "2024-02-02", 300, importer.Expense},
+	}
+	for i, w := range want {
+		row := rows[i]
+		if row.Err != nil {
+			t.Fatalf("row %d: %v", row.Line, row.Err)
+		}
+		if row.Line != w.line || row.Date.Format("2006-01-02") != w.date || row.Amount != w.amount || row.Type != w.kind {
+			t.Fatalf("row %d = %+v, want %+v", i, row, w)
+		}
+	}
+
+	if rows[3].Line != 6 || rows[3].Err == nil {
+		t.Fatalf("expected line 6 to fail, got %+v", rows[3])
+	}
+}
+
+func TestParseCSVDebitCreditColumns(t *testing.T) {
+	data := []byte("2024-03-01,Coffee,4.50,\n" +
+		"2024-03-02,Paycheck,,\"1,200.00\"\n")
+
+	rows, err := importer.ParseCSV(data, importer.CSVMapping{
+		DateColumn:        "1",
+		DescriptionColumn: "2",
+		DebitColumn:       "3",
+		CreditColumn:      "4",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].Type != importer.Expense || rows[0].Amount != 450 || rows[0].Description != "Coffee" {
+		t.Fatalf("row 1 = %+v", rows[0])
+	}
+	if rows[1].Type != importer.Income || rows[1].Amount != 120000 {
+		t.Fatalf("row 2 = %+v", rows[1])
+	}
+}
+
+func TestParseCSVPositiveExpense(t *testing.T) {
+	rows, err := importer.ParseCSV([]byte("2024-03-01,12.00,Card purchase\n"), importer.CSVMapping{
+		DateColumn:        "1",
+		AmountColumn:      "2",
+		DescriptionColumn: "3",
+		AmountSign:        importer.PositiveExpense,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Type != importer.Expense || rows[0].Amount != 1200 {
+		t.Fatalf("row = %+v", rows[0])
+	}
+}
+
+func TestParseCSVMappingErrors(t *testing.T) {
+	mappings := []importer.CSVMapping{
+		{AmountColumn: "2"},
+		{DateColumn: "1"},
+		{DateColumn: "1", AmountColumn: "2", AmountSign: "sideways"},
+		{HasHeader: true, DateColumn: "When", AmountColumn: "Amount"},
+	}
+	for i, mapping := range mappings {
+		if _, err := importer.ParseCSV([]byte("Date,Amount\n2024-01-01,1\n"), mapping); err == nil {
+			t.Fatalf("mapping %d: expected an error", i)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	common_pb "budgeting-service/genproto/common"
+	"budgeting-service/internal/items/money"
+)
+
+func TestMoneyFromProto(t *testing.T) {
+	cases := []struct {
+		in   *common_pb.Money
+		want int64
+	}{
+		{nil, 0},
+		{&common_pb.Money{Units: 12, Nanos: 340_000_000}, 1234},
+		{&common_pb.Money{Units: -12, Nanos: -340_000_000}, -1234},
+		{&common_pb.Money{Nanos: 10_000_000}, 1},
+		{&common_pb.Money{Nanos: -10_000_000}, -1},
+	}
+	for _, c := range cases {
+		got, err := money.FromProto(c.in)
+		if err != nil || got != c.want {
+			t.Fatalf("FromProto(%v) = %d, %v, want %d", c.in, got, err, c.want)
+		}
+	}
+
+	for _, bad := range []*common_pb.Money{
+		{Units: 1, Nanos: -1},
+		{Nanos: 1_000_000_000},
+		{Units: 1 << 62},
+		{Nanos: 5_000_000},
+		{Units: 1, Nanos: 4_999_999},
+	} {
+		if _, err := money.FromProto(bad); !errors.Is(err, money.ErrInvalid) {
+			t.Fatalf("FromProto(%v) error = %v, want ErrInvalid", bad, err)
+		}
+	}
+}
+
+func TestMoneyRoundTrip(t *testing.T) {
+	for _, cents := range []int64{0, 1, -1, 1234, -99, 100000001} {
+		got, err := money.FromProto(money.ToProto(cents, "USD"))
+		if err != nil || got != cents {
+			t.Fatalf("round trip of %d = %d, %v", cents, got, err)
+		}
+	}
+}
+
+func TestMoneyAmountCurrency(t *testing.T) {
+	if _, err := money.Amount(&common_pb.Money{Units: 5, CurrencyCode: "EUR"}, "USD"); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Fatalf("error = %v, want ErrCurrencyMismatch", err)
+	}
+	if got, err := money.Amount(&common_pb.Money{Units: 5, CurrencyCode: "usd"}, "USD"); err != nil || got != 500 {
+		t.Fatalf("got %d, %v", got, err)
+	}
+	if got, err := money.Amount(&common_pb.Money{Units: 5}, "USD"); err != nil || got != 500 {
+		t.Fatalf("got %d, %v", got, err)
+	}
+}
+
+func TestMoneyParseAndFormat(t *testing.T) {
+	cases := map[string]int64{
+		"0.1":     10,
+		"0.10":    10,
+		"-12.345": -1235,
+		"12.344":  1234,
+		"+7":      700,
+		".5":      50,
+		"1000000": 100000000,
+	}
+	for in, want := range cases {
+		got, err := money.Parse(in)
+		if err != nil || got != want {
+			t.Fatalf("Parse(%q) = %d, %v, want %d", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "-", "1.2.3", "1e3", "abc"} {
+		if _, err := money.Parse(bad); err == nil {
+			t.Fatalf("Parse(%q): expected an error", bad)
+		}
+	}
+
+	if got := money.Format(-5); got != "-0.05" {
+		t.Fatalf("Format(-5) = %s", got)
+	}
+	if got := money.Format(123456); got != "1234.56" {
+		t.Fatalf("Format(123456) = %s", got)
+	}
+}
+
+func TestMoneySumIsExact(t *testing.T) {
+	var total int64
+	for i := 0; i < 1000; i++ {
+		cents, _ := money.Parse("0.10")
+		total += cents
+	}
+	if money.Format(total) != "100.00" {
+		t.Fatalf("sum = %s, want 100.00", money.Format(total))
+	}
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	pb "budgeting-service/genproto/account"
+	"budgeting-service/internal/items/goaltrack"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+	"budgeting-service/internal/items/repository"
+	"budgeting-service/internal/items/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAccounts is an in-memory AccountI that records what reached storage.
+type fakeAccounts struct {
+	accounts map[string]*pb.AccountResponse
+	created  *pb.CreateAccountRequest
+	updated  *pb.UpdateAccountRequest
+	deleted  []string
+}
+
+func (f *fakeAccounts) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.AccountResponse, error) {
+	f.created = req
+	return &pb.AccountResponse{Id: "new", UserId: req.UserId, Name: req.Name}, nil
+}
+
+func (f *fakeAccounts) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{}, nil
+}
+
+func (f *fakeAccounts) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRequest) (*pb.AccountResponse, error) {
+	return f.accounts[req.Id], nil
+}
+
+func (f *fakeAccounts) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.AccountResponse, error) {
+	f.updated = req
+	return f.accounts[req.Id], nil
+}
+
+func (f *fakeAccounts) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.Empty, error) {
+	f.deleted = append(f.deleted, req.Id)
+	return &pb.Empty{}, nil
+}
+
+// noGoals is a GoalI whose goals never change when accounts do.
+type noGoals struct {
+	repository.GoalI
+}
+
+func (noGoals) RefreshGoalProgress(ctx context.Context, userID string) ([]*goaltrack.Alert, error) {
+	return nil, nil
+}
+
+func (noGoals) CheckGoalPace(ctx context.Context, userID string, now time.Time) ([]*goaltrack.Alert, error) {
+	return nil, nil
+}
+
+func newAccountService() (*service.AccountService, *fakeAccounts) {
+	storage := &fakeAccounts{accounts: map[string]*pb.AccountResponse{
+		"acc-alice": {Id: "acc-alice", UserId: "alice"},
+		"acc-bob":   {Id: "acc-bob", UserId: "bob"},
+	}}
+	return service.NewAccountService(storage, noGoals{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil))), storage
+}
+
+func as(userID, role string) context.Context {
+	return jwttokens.WithClaims(context.Background(), &jwttokens.Claims{UserID: userID, Role: role})
+}
+
+func TestOwnershipOfDocuments(t *testing.T) {
+	accounts, storage := newAccountService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	if _, err := accounts.GetAccountById(alice, &pb.GetAccountByIdRequest{Id: "acc-alice"}); err != nil {
+		t.Fatalf("own account: %v", err)
+	}
+	if _, err := accounts.GetAccountById(alice, &pb.GetAccountByIdRequest{Id: "acc-bob"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("other user's account: got %v, want NotFound", err)
+	}
+	if _, err := accounts.GetAccountById(alice, &pb.GetAccountByIdRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("missing account: got %v, want NotFound", err)
+	}
+	if _, err := accounts.DeleteAccount(alice, &pb.DeleteAccountRequest{Id: "acc-bob"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("deleting other user's account: got %v, want NotFound", err)
+	}
+	if len(storage.deleted) != 0 {
+		t.Fatalf("storage deleted %v", storage.deleted)
+	}
+
+	admin := as("root", jwttokens.RoleAdmin)
+	if _, err := accounts.DeleteAccount(admin, &pb.DeleteAccountRequest{Id: "acc-bob"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("admin without any-user scope: got %v, want NotFound", err)
+	}
+	if _, err := accounts.GetAccountById(rbac.WithAnyUser(admin), &pb.GetAccountByIdRequest{Id: "acc-bob"}); err != nil {
+		t.Fatalf("admin with any-user scope: %v", err)
+	}
+
+	if _, err := accounts.GetAccountById(context.Background(), &pb.GetAccountByIdRequest{Id: "acc-alice"}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("no claims: got %v, want Unauthenticated", err)
+	}
+}
+
+// wallet is a valid request to open an account for userID.
+func wallet(userID string) *pb.CreateAccountRequest {
+	return &pb.CreateAccountRequest{UserId: userID, Name: "Wallet", Type: "cash", Currency: "USD"}
+}
+
+func TestOwnershipOfRequestUser(t *testing.T) {
+	accounts, storage := newAccountService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	if _, err := accounts.CreateAccount(alice, wallet("")); err != nil {
+		t.Fatal(err)
+	}
+	if storage.created.UserId != "alice" {
+		t.Fatalf("empty user id filled with %q, want alice", storage.created.UserId)
+	}
+
+	if _, err := accounts.CreateAccount(alice, &pb.CreateAccountRequest{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("acting for another user: got %v, want PermissionDenied", err)
+	}
+
+	if _, err := accounts.CreateAccount(as("root", jwttokens.RoleAdmin), &pb.CreateAccountRequest{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("admin without any-user scope: got %v, want PermissionDenied", err)
+	}
+
+	consumer := rbac.WithAnyUser(jwttokens.WithClaims(context.Background(), jwttokens.ServiceClaims("kafka-consumer")))
+	if _, err := accounts.CreateAccount(consumer, wallet("bob")); err != nil || storage.created.UserId != "bob" {
+		t.Fatalf("service acting for bob: %v, user %q", err, storage.created.UserId)
+	}
+	if _, err := accounts.CreateAccount(consumer, &pb.CreateAccountRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("service without user: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,71 @@
+package test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"budgeting-service/api"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/rbac"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRBACPolicy(t *testing.T) {
+	enforcer, err := rbac.NewEnforcer("../../casbin/rbac_model.conf", "../../casbin/policy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := api.UnaryRBACInterceptor(enforcer, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	tests := []struct {
+		role    string
+		method  string
+		code    codes.Code
+		anyUser bool
+	}{
+		{jwttokens.RoleUser, "/budget.BudgetService/DeleteBudget", codes.OK, false},
+		{jwttokens.RoleUser, "/transaction.TransactionService/GetTransactionById", codes.OK, false},
+		{jwttokens.RoleAdmin, "/transaction.TransactionService/GetTransactionById", codes.OK, true},
+		{jwttokens.RoleAdmin, "/transaction.TransactionService/ExportTransactions", codes.OK, true},
+		{jwttokens.RoleUser, "/transaction.TransactionService/ApplyRules", codes.OK, false},
+		{jwttokens.RoleAdmin, "/report.ReportService/GetSpendingReport", codes.OK, true},
+		{jwttokens.RoleAdmin, "/budget.BudgetService/DeleteBudget", codes.OK, false},
+		{jwttokens.RoleAdmin, "/notification.NotificationService/MarkNotificationAsRead", codes.OK, false},
+		{jwttokens.RoleUser, "/currency.CurrencyService/UpsertExchangeRates", codes.PermissionDenied, false},
+		{jwttokens.RoleAdmin, "/currency.CurrencyService/UpsertExchangeRates", codes.OK, false},
+		{jwttokens.RoleUser, "/currency.CurrencyService/UpdateUserSettings", codes.OK, false},
+		{jwttokens.RoleUser, "/category.CategoryService/SeedDefaultCategories", codes.OK, false},
+		{jwttokens.RoleUser, "/category.CategoryService/PushDefaultCategories", codes.PermissionDenied, false},
+		{jwttokens.RoleAdmin, "/category.CategoryService/PushDefaultCategories", codes.OK, false},
+		{jwttokens.RoleAdmin, "/category.CategoryService/GetCategoryTree", codes.OK, true},
+		{jwttokens.RoleUser, "/category.CategoryService/MergeCategories", codes.OK, false},
+		{jwttokens.RoleAdmin, "/category.CategoryService/ArchiveCategory", codes.OK, false},
+		{"", "/budget.BudgetService/GetBudgets", codes.PermissionDenied, false},
+		{"guest", "/budget.BudgetService/GetBudgets", codes.PermissionDenied, false},
+		{jwttokens.RoleUser, "/auth.AuthService/CreateAdmin", codes.PermissionDenied, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+tt.method, func(t *testing.T) {
+			ctx := jwttokens.WithClaims(context.Background(), &jwttokens.Claims{UserID: "u1", Role: tt.role})
+
+			var anyUser bool
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					anyUser = rbac.AnyUser(ctx)
+					return nil, nil
+				})
+			if status.Code(err) != tt.code {
+				t.Fatalf("got %v, want %v", err, tt.code)
+			}
+			if anyUser != tt.anyUser {
+				t.Fatalf("any user = %v, want %v", anyUser, tt.anyUser)
+			}
+		})
+	}
+}
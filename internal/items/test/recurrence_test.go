@@ -0,0 +1,91 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"budgeting-service/internal/items/recurrence"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	tests := []struct {
+		name string
+		rule recurrence.Rule
+		want []string
+	}{
+		{
+			name: "daily",
+			rule: recurrence.Rule{Frequency: recurrence.Daily, Start: date("2024-02-27")},
+			want: []string{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01"},
+		},
+		{
+			name: "biweekly",
+			rule: recurrence.Rule{Frequency: recurrence.Biweekly, Start: date("2024-01-05")},
+			want: []string{"2024-01-05", "2024-01-19", "2024-02-02"},
+		},
+		{
+			name: "monthly on the 31st clamps to short months",
+			rule: recurrence.Rule{Frequency: recurrence.Monthly, DayOfMonth: 31, Start: date("2024-01-15")},
+			want: []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"},
+		},
+		{
+			name: "monthly day before start skips to next month",
+			rule: recurrence.Rule{Frequency: recurrence.Monthly, DayOfMonth: 1, Start: date("2024-11-15")},
+			want: []string{"2024-12-01", "2025-01-01", "2025-02-01"},
+		},
+		{
+			name: "quarterly",
+			rule: recurrence.Rule{Frequency: recurrence.Monthly, Interval: 3, Start: date("2024-01-10")},
+			want: []string{"2024-01-10", "2024-04-10", "2024-07-10"},
+		},
+		{
+			name: "yearly on leap day",
+			rule: recurrence.Rule{Frequency: recurrence.Yearly, Start: date("2024-02-29")},
+			want: []string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			occurrence := tt.rule.First()
+			for i, want := range tt.want {
+				if got := occurrence.Format("2006-01-02"); got != want {
+					t.Fatalf("occurrence %d = %s, want %s", i, got, want)
+				}
+				occurrence = tt.rule.Next(occurrence)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNextFromArbitraryDate(t *testing.T) {
+	rule := recurrence.Rule{Frequency: recurrence.Weekly, Start: date("2024-01-01")}
+
+	if got := rule.Next(date("2024-03-13")).Format("2006-01-02"); got != "2024-03-18" {
+		t.Fatalf("Next = %s, want 2024-03-18", got)
+	}
+	if got := rule.Next(date("2023-06-01")).Format("2006-01-02"); got != "2024-01-01" {
+		t.Fatalf("Next before start = %s, want 2024-01-01", got)
+	}
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	if err := (recurrence.Rule{Frequency: "hourly", Start: date("2024-01-01")}).Validate(); err == nil {
+		t.Fatal("expected an error for an unknown frequency")
+	}
+	if err := (recurrence.Rule{Frequency: recurrence.Monthly, DayOfMonth: 32, Start: date("2024-01-01")}).Validate(); err == nil {
+		t.Fatal("expected an error for day of month 32")
+	}
+}
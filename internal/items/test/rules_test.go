@@ -0,0 +1,131 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"budgeting-service/internal/items/rules"
+)
+
+func newRule(t *testing.T, id string, conditions rules.Conditions, actions rules.Actions) rules.Rule {
+	t.Helper()
+	rule, err := rules.New(id, conditions, actions)
+	if err != nil {
+		t.Fatalf("rule %s: %v", id, err)
+	}
+	return rule
+}
+
+func TestRulesNewViolations(t *testing.T) {
+	_, err := rules.New("", rules.Conditions{Currency: "USD"}, rules.Actions{AddTags: []string{" "}})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[conditions actions]" {
+		t.Fatalf("violations on %s, want [conditions actions]", got)
+	}
+
+	_, err = rules.New("", rules.Conditions{
+		DescriptionRegex: "(",
+		MinAmount:        500,
+		MaxAmount:        100,
+		Type:             "transfer_in",
+	}, rules.Actions{CategoryID: "food"})
+	want := "[conditions.description_regex conditions.max_amount conditions.type]"
+	if got := fmt.Sprint(fieldViolations(t, err)); got != want {
+		t.Fatalf("violations on %s, want %s", got, want)
+	}
+}
+
+func TestRulesMatches(t *testing.T) {
+	tx := rules.Transaction{
+		AccountID:   "card",
+		Type:        "expense",
+		Description: "STARBUCKS #1234 Seattle",
+		Amount:      450,
+		Currency:    "USD",
+	}
+
+	tests := []struct {
+		name       string
+		conditions rules.Conditions
+		want       bool
+	}{
+		{"contains ignores case", rules.Conditions{DescriptionContains: "starbucks"}, true},
+		{"contains misses", rules.Conditions{DescriptionContains: "costa"}, false},
+		{"regex", rules.Conditions{DescriptionRegex: `#\d+`}, true},
+		{"amount in range", rules.Conditions{MinAmount: 100, MaxAmount: 500, Currency: "usd"}, true},
+		{"amount above max", rules.Conditions{MaxAmount: 400}, false},
+		{"amount in another currency", rules.Conditions{MinAmount: 100, Currency: "EUR"}, false},
+		{"other account", rules.Conditions{AccountID: "cash"}, false},
+		{"other type", rules.Conditions{Type: "income"}, false},
+		{"all conditions", rules.Conditions{DescriptionContains: "seattle", AccountID: "card", Type: "expense", MinAmount: 450}, true},
+	}
+	for _, tt := range tests {
+		rule := newRule(t, "r", tt.conditions, rules.Actions{AddTags: []string{"coffee"}})
+		if got := rule.Matches(tx); got != tt.want {
+			t.Errorf("%s: Matches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRulesApply(t *testing.T) {
+	categories := map[string]string{"coffee": "expense", "salary": "income", "eating-out": "expense"}
+	ruleset := []rules.Rule{
+		newRule(t, "first", rules.Conditions{DescriptionContains: "starbucks"},
+			rules.Actions{CategoryID: "coffee", AddTags: []string{"Coffee", "work"}, SetDescription: "Starbucks"}),
+		newRule(t, "second", rules.Conditions{DescriptionContains: "starbucks"},
+			rules.Actions{CategoryID: "eating-out", AddTags: []string{"coffee", "treat"}, SetDescription: "Coffee"}),
+		newRule(t, "income", rules.Conditions{Type: "income"}, rules.Actions{AddTags: []string{"salary"}}),
+	}
+	tx := rules.Transaction{Type: "expense", Description: "STARBUCKS #1234", Tags: []string{"work"}}
+
+	got := rules.Apply(ruleset, tx, categories)
+	if fmt.Sprint(got.RuleIDs) != "[first second]" {
+		t.Errorf("rules %v, want [first second]", got.RuleIDs)
+	}
+	if got.CategoryID != "coffee" || got.Description != "Starbucks" {
+		t.Errorf("category %q, description %q, want the first rule's", got.CategoryID, got.Description)
+	}
+	if fmt.Sprint(got.AddTags) != "[coffee treat]" {
+		t.Errorf("tags %v, want [coffee treat]", got.AddTags)
+	}
+
+	categorized := tx
+	categorized.CategoryID = "groceries"
+	if got := rules.Apply(ruleset, categorized, categories); got.CategoryID != "" {
+		t.Errorf("recategorized a transaction that has a category: %q", got.CategoryID)
+	}
+
+	split := tx
+	split.Split = true
+	if got := rules.Apply(ruleset, split, categories); got.CategoryID != "" {
+		t.Errorf("categorized a split transaction: %q", got.CategoryID)
+	}
+
+	wrongType := []rules.Rule{newRule(t, "r", rules.Conditions{DescriptionContains: "starbucks"}, rules.Actions{CategoryID: "salary"})}
+	if got := rules.Apply(wrongType, tx, categories); got.CategoryID != "" || got.Changed() {
+		t.Errorf("set an income category on an expense: %+v", got)
+	}
+
+	archived := []rules.Rule{newRule(t, "r", rules.Conditions{DescriptionContains: "starbucks"}, rules.Actions{CategoryID: "gone"})}
+	if got := rules.Apply(archived, tx, categories); got.CategoryID != "" {
+		t.Errorf("set an unknown category: %q", got.CategoryID)
+	}
+}
+
+func TestRulesTagUpdate(t *testing.T) {
+	if tags, ok := rules.TagUpdate(nil, false); ok {
+		t.Errorf("no tags changed them to %v", tags)
+	}
+	if tags, ok := rules.TagUpdate(nil, true); !ok || tags == nil || len(tags) != 0 {
+		t.Errorf("replacing with no tags = %v, %v, want an empty list", tags, ok)
+	}
+	if tags, ok := rules.TagUpdate([]string{"Travel ", "travel"}, false); !ok || fmt.Sprint(tags) != "[travel]" {
+		t.Errorf("new tags = %v, %v, want [travel]", tags, ok)
+	}
+}
+
+func TestRulesTags(t *testing.T) {
+	got := rules.Tags([]string{" Travel", "travel", "", "Work ", "  "})
+	if fmt.Sprint(got) != "[travel work]" {
+		t.Fatalf("Tags = %v, want [travel work]", got)
+	}
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"testing"
+
+	"budgeting-service/internal/items/importer"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20240201</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240115120000.000[-5:EST]
+<TRNAMT>-42.17
+<FITID>2024011501
+<NAME>GROCERY MART
+<MEMO>Card 1234
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240131
+<TRNAMT>1500.00
+<FITID>2024013101
+<NAME>ACME PAYROLL
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>1457.83<DTASOF>20240131</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+    <CURDEF>EUR</CURDEF>
+    <BANKTRANLIST>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20240302</DTPOSTED>
+        <TRNAMT>-9,99</TRNAMT>
+        <FITID>A-1</FITID>
+        <NAME>Books &amp; More</NAME>
+      </STMTTRN>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>bad</DTPOSTED>
+        <TRNAMT>-1.00</TRNAMT>
+        <FITID>A-2</FITID>
+      </STMTTRN>
+    </BANKTRANLIST>
+  </CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFXSGML(t *testing.T) {
+	statement, err := importer.ParseStatement("qfx", []byte(sgmlStatement), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if statement.Currency != "USD" {
+		t.Fatalf("currency = %q, want USD", statement.Currency)
+	}
+	if statement.LedgerBalance == nil || *statement.LedgerBalance != 145783 {
+		t.Fatalf("ledger balance = %v, want 145783", statement.LedgerBalance)
+	}
+	if got := statement.LedgerDate.Format("2006-01-02"); got != "2024-01-31" {
+		t.Fatalf("ledger date = %s, want 2024-01-31", got)
+	}
+	if len(statement.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(statement.Rows))
+	}
+
+	first := statement.Rows[0]
+	if first.Err != nil || first.ExternalID != "2024011501" || first.Type != importer.Expense ||
+		first.Amount != 4217 || first.Description != "GROCERY MART - Card 1234" ||
+		first.Date.Format("2006-01-02") != "2024-01-15" {
+		t.Fatalf("row 1 = %+v", first)
+	}
+
+	second := statement.Rows[1]
+	if second.Err != nil || second.Type != importer.Income || second.Amount != 150000 {
+		t.Fatalf("row 2 = %+v", second)
+	}
+}
+
+func TestParseOFXXML(t *testing.T) {
+	statement, err := importer.ParseStatement("ofx", []byte(xmlStatement), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if statement.Currency != "EUR" || statement.LedgerBalance != nil {
+		t.Fatalf("statement = %+v", statement)
+	}
+	if len(statement.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(statement.Rows))
+	}
+	if row := statement.Rows[0]; row.Err != nil || row.Description != "Books & More" || row.Amount != 999 || row.ExternalID != "A-1" {
+		t.Fatalf("row 1 = %+v", row)
+	}
+	if row := statement.Rows[1]; row.Err == nil || row.Line != 2 || row.ExternalID != "A-2" {
+		t.Fatalf("expected row 2 to fail, got %+v", row)
+	}
+}
+
+func TestParseQIF(t *testing.T) {
+	data := []byte("!Account\nNChecking\nTBank\n^\n" +
+		"!Type:Bank\n" +
+		"D31/01'24\nT-1,250.00\nPLandlord\nMJanuary rent\n^\n" +
+		"D 1/ 2/2024\nU300.00\nPRefund\nLGroceries\n^\n" +
+		"D32/01/2024\nT-1.00\n^\n")
+
+	statement, err := importer.ParseStatement("qif", data, "DD/MM/YYYY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statement.LedgerBalance != nil {
+		t.Fatal("QIF has no ledger balance")
+	}
+	if len(statement.Rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(statement.Rows))
+	}
+
+	rent := statement.Rows[0]
+	if rent.Err != nil || rent.Line != 6 || rent.Date.Format("2006-01-02") != "2024-01-31" ||
+		rent.Amount != 125000 || rent.Type != importer.Expense || rent.Description != "Landlord - January rent" {
+		t.Fatalf("row 1 = %+v", rent)
+	}
+	refund := statement.Rows[1]
+	if refund.Err != nil || refund.Date.Format("2006-01-02") != "2024-02-01" || refund.Type != importer.Income {
+		t.Fatalf("row 2 = %+v", refund)
+	}
+	if statement.Rows[2].Err == nil {
+		t.Fatal("expected an invalid date error")
+	}
+}
+
+func TestParseStatementErrors(t *testing.T) {
+	if _, err := importer.ParseStatement("pdf", nil, ""); err == nil {
+		t.Fatal("expected an error for an unsupported format")
+	}
+	if _, err := importer.ParseStatement("ofx", []byte("hello"), ""); err == nil {
+		t.Fatal("expected an error for a file without <OFX>")
+	}
+	if _, err := importer.ParseStatement("qif", []byte("D1/1/2024\nT1\n^\n"), ""); err == nil {
+		t.Fatal("expected an error for a file without a !Type header")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	account_pb "budgeting-service/genproto/account"
 	budget_pb "budgeting-service/genproto/budget"
 	category_pb "budgeting-service/genproto/category"
+	common_pb "budgeting-service/genproto/common"
 	goal_pb "budgeting-service/genproto/goal"
 	transaction_pb "budgeting-service/genproto/transaction"
 
@@ -15,6 +16,7 @@ import (
 	"testing"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"log"
@@ -48,37 +50,74 @@ func setupStorage() (storage.StrorageI, *mongo.Database) {
 	), db
 }
 
-func TestCreateAccount(t *testing.T) {
-	storage, db := setupStorage()
+// fixtureAccount creates an account for tests that need one to exist and
+// removes it when the test ends.
+func fixtureAccount(t *testing.T, storage storage.StrorageI, db *mongo.Database, userID string) string {
+	t.Helper()
 	ctx := context.Background()
 
-	test := account_pb.CreateAccountRequest{
-		UserId:   "68819df6-1db1-447a-837e-4f4bd6ec577f",
+	res, err := storage.Account().CreateAccount(ctx, &account_pb.CreateAccountRequest{
+		UserId:   userID,
 		Name:     "test",
-		Type:     "test",
-		Balance:  1000,
+		Type:     "checking",
+		Balance:  &common_pb.Money{Units: 1000, CurrencyCode: "UZS"},
 		Currency: "UZS",
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
+	t.Cleanup(func() { deleteByID(t, db, "accounts", res.Id) })
 
-	res, err := storage.Account().CreateAccount(ctx, &test)
+	return res.Id
+}
+
+// fixtureCategory creates a category of the given type and removes it when
+// the test ends.
+func fixtureCategory(t *testing.T, storage storage.StrorageI, db *mongo.Database, userID, categoryType string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	res, err := storage.Category().CreateCategory(ctx, &category_pb.CreateCategoryRequest{
+		UserId: userID,
+		Name:   "test",
+		Type:   categoryType,
+	})
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
+	t.Cleanup(func() { deleteByID(t, db, "categories", res.Id) })
+
+	return res.Id
+}
 
-	_, err = db.Collection("accounts").DeleteOne(ctx, bson.M{"_id": res.Id})
+func deleteByID(t *testing.T, db *mongo.Database, collection, id string) {
+	t.Helper()
+
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		t.Error(err)
+		return
+	}
+	if _, err := db.Collection(collection).DeleteOne(context.Background(), bson.M{"_id": objID}); err != nil {
+		t.Error(err)
 	}
 }
 
+func TestCreateAccount(t *testing.T) {
+	storage, db := setupStorage()
+
+	fixtureAccount(t, storage, db, "68819df6-1db1-447a-837e-4f4bd6ec577f")
+}
+
 func TestCreateBudget(t *testing.T) {
 	storage, db := setupStorage()
 	ctx := context.Background()
+	userID := "4ed1de6b-d3de-4811-aac2-7d86bd544659"
 
 	test := budget_pb.CreateBudgetRequest{
-		UserId:     "4ed1de6b-d3de-4811-aac2-7d86bd544659",
-		CategoryId: "68819df6-1db1-447a-837e-4f4bd6ec577f",
-		Amount:     1000,
+		UserId:     userID,
+		CategoryId: fixtureCategory(t, storage, db, userID, "expense"),
+		Amount:     &common_pb.Money{Units: 1000, CurrencyCode: "UZS"},
 		Period:     "monthly",
 		StartDate:  "2023-01-01",
 		EndDate:    "2023-12-31",
@@ -86,33 +125,16 @@ func TestCreateBudget(t *testing.T) {
 
 	res, err := storage.Budget().CreateBudget(ctx, &test)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
-	_, err = db.Collection("budgets").DeleteOne(ctx, bson.M{"_id": res.Id})
-	if err != nil {
-		t.Error(err)
-	}
+	deleteByID(t, db, "budgets", res.Id)
 }
 
 func TestCategory(t *testing.T) {
 	storage, db := setupStorage()
-	ctx := context.Background()
-
-	test := category_pb.CreateCategoryRequest{
-		UserId: "4ed1de6b-d3de-4811-aac2-7d86bd544659",
-		Name:   "test",
-		Type:   "test",
-	}
 
-	res, err := storage.Category().CreateCategory(ctx, &test)
-	if err != nil {
-		t.Error(err)
-	}
-	_, err = db.Collection("categories").DeleteOne(ctx, bson.M{"_id": res.Id})
-	if err != nil {
-		t.Error(err)
-	}
+	fixtureCategory(t, storage, db, "4ed1de6b-d3de-4811-aac2-7d86bd544659", "expense")
 }
 
 func TestCreateGoal(t *testing.T) {
@@ -122,44 +144,39 @@ func TestCreateGoal(t *testing.T) {
 	test := goal_pb.CreateGoalRequest{
 		UserId:        "4ed1de6b-d3de-4811-aac2-7d86bd544659",
 		Name:          "test",
-		TargetAmount:  1000,
-		CurrentAmount: 0,
+		TargetAmount:  &common_pb.Money{Units: 1000, CurrencyCode: "UZS"},
+		CurrentAmount: &common_pb.Money{CurrencyCode: "UZS"},
 		Deadline:      "2023-12-31",
 		Status:        "active",
 	}
 
 	res, err := storage.Goal().CreateGoal(ctx, &test)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
-	_, err = db.Collection("goals").DeleteOne(ctx, bson.M{"_id": res.Id})
-	if err != nil {
-		t.Error(err)
-	}
+	deleteByID(t, db, "goals", res.Id)
 }
 
 func TestCreateTransaction(t *testing.T) {
 	storage, db := setupStorage()
 	ctx := context.Background()
+	userID := "4ed1de6b-d3de-4811-aac2-7d86bd544659"
 
 	test := transaction_pb.CreateTransactionRequest{
-		UserId:      "4ed1de6b-d3de-4811-aac2-7d86bd544659",
-		AccountId:   "68819df6-1db1-447a-837e-4f4bd6ec577f",
-		CategoryId:  "68819df6-1db1-447a-837e-4f4bd6ec577f",
-		Amount:      1000,
-		Type:        "test",
+		UserId:      userID,
+		AccountId:   fixtureAccount(t, storage, db, userID),
+		CategoryId:  fixtureCategory(t, storage, db, userID, "expense"),
+		Amount:      &common_pb.Money{Units: 1000, CurrencyCode: "UZS"},
+		Type:        "expense",
 		Description: "test",
 		Date:        "2023-12-31",
 	}
 
 	res, err := storage.Transaction().CreateTransaction(ctx, &test)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
-	_, err = db.Collection("transactions").DeleteOne(ctx, bson.M{"_id": res.Id})
-	if err != nil {
-		t.Error(err)
-	}
+	deleteByID(t, db, "transactions", res.Id)
 }
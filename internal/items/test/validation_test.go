@@ -0,0 +1,133 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "budgeting-service/genproto/account"
+	common_pb "budgeting-service/genproto/common"
+	jwttokens "budgeting-service/internal/items/jwt"
+	"budgeting-service/internal/items/recurrence"
+	"budgeting-service/internal/items/validation"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fieldViolations returns the fields named in err's BadRequest detail,
+// failing unless err is an InvalidArgument status carrying one.
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("got %v, want InvalidArgument", err)
+	}
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	if len(fields) == 0 {
+		t.Fatalf("%v has no field violations", err)
+	}
+	return fields
+}
+
+func TestValidationCollectsViolations(t *testing.T) {
+	var v validation.Violations
+	v.Required("name", " ")
+	v.OneOf("type", "transfer", validation.Income, validation.Expense)
+	v.ID("account_id", "not-an-id")
+	v.OptionalID("goal_id", "")
+	v.Positive("amount", &common_pb.Money{Units: -5})
+	start := v.Date("start_date", "2024-03-01")
+	v.NotBefore("end_date", v.Date("end_date", "2024-02-01"), "start_date", start)
+	v.OptionalDate("due_date", "")
+	v.Currency("currency", "US1")
+
+	got := fmt.Sprint(fieldViolations(t, v.Err()))
+	want := "[name type account_id amount end_date currency]"
+	if got != want {
+		t.Fatalf("violations on %s, want %s", got, want)
+	}
+}
+
+func TestValidationPassesValidRequest(t *testing.T) {
+	var v validation.Violations
+	v.Required("name", "Groceries")
+	v.OneOf("type", validation.Expense, validation.Income, validation.Expense)
+	v.ID("account_id", "65f1c0d2a1b2c3d4e5f60718")
+	v.Positive("amount", &common_pb.Money{Units: 12, Nanos: 500_000_000})
+	v.NotBefore("end_date", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "start_date", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	if currency := v.Currency("currency", " eur "); currency != "EUR" {
+		t.Fatalf("currency %q, want EUR", currency)
+	}
+
+	if err := v.Err(); err != nil {
+		t.Fatalf("valid request: %v", err)
+	}
+}
+
+func TestValidationErrorSurvivesWrapping(t *testing.T) {
+	err := fmt.Errorf("creating budget: %w", validation.Field("category_id", "category %s not found", "abc"))
+	if got := fieldViolations(t, err); len(got) != 1 || got[0] != "category_id" {
+		t.Fatalf("violations on %v, want [category_id]", got)
+	}
+}
+
+func TestRecurrenceRuleViolations(t *testing.T) {
+	err := recurrence.Rule{Frequency: "hourly", Interval: -1, DayOfMonth: 40}.Validate()
+	got := fmt.Sprint(fieldViolations(t, err))
+	if want := "[frequency interval day_of_month start_date]"; got != want {
+		t.Fatalf("violations on %s, want %s", got, want)
+	}
+}
+
+func TestServiceRejectsInvalidRequest(t *testing.T) {
+	accounts, storage := newAccountService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	_, err := accounts.CreateAccount(alice, &pb.CreateAccountRequest{Name: "Wallet", Type: "piggy_bank", Currency: "dollars"})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[type currency]" {
+		t.Fatalf("violations on %s, want [type currency]", got)
+	}
+	if storage.created != nil {
+		t.Fatalf("invalid request reached storage: %v", storage.created)
+	}
+
+	_, err = accounts.UpdateAccount(alice, &pb.UpdateAccountRequest{Id: "acc-alice"})
+	if got := fmt.Sprint(fieldViolations(t, err)); got != "[id]" {
+		t.Fatalf("violations on %s, want [id]", got)
+	}
+}
+
+func TestServiceAcceptsLowerCaseCurrency(t *testing.T) {
+	accounts, storage := newAccountService()
+	alice := as("alice", jwttokens.RoleUser)
+
+	// Validation only checks the code; storage writes it in upper case.
+	req := wallet("alice")
+	req.Currency = " eur "
+	if _, err := accounts.CreateAccount(alice, req); err != nil {
+		t.Fatal(err)
+	}
+	if storage.created.Currency != " eur " {
+		t.Fatalf("validation rewrote currency to %q", storage.created.Currency)
+	}
+
+	id := "65f1c0d2a1b2c3d4e5f60718"
+	storage.accounts[id] = &pb.AccountResponse{Id: id, UserId: "alice"}
+	if _, err := accounts.UpdateAccount(alice, &pb.UpdateAccountRequest{Id: id, Currency: "gbp"}); err != nil {
+		t.Fatal(err)
+	}
+	if storage.updated.Currency != "gbp" {
+		t.Fatalf("validation rewrote currency to %q", storage.updated.Currency)
+	}
+}
@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	common_pb "budgeting-service/genproto/common"
+	"budgeting-service/internal/items/exchange"
+	"budgeting-service/internal/items/money"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Types that transactions and categories can have. A category only takes
+// transactions of its own type.
+const (
+	Income  = "income"
+	Expense = "expense"
+)
+
+// AccountTypes are the kinds of account a user can open.
+var AccountTypes = []string{"checking", "savings", "credit_card", "cash", "investment", "loan", "other"}
+
+// Error is a request that failed validation. gRPC reports it as
+// codes.InvalidArgument with a BadRequest detail naming every field at fault,
+// so it can be returned as is from any layer.
+type Error struct {
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Field returns an Error for a single field.
+func Field(field, format string, args ...interface{}) error {
+	var v Violations
+	v.Add(field, format, args...)
+	return v.Err()
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		parts[i] = violation.Field + ": " + violation.Description
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e.Violations})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Violations collects everything wrong with a request, so the caller hears
+// about all of it at once rather than one field per attempt.
+type Violations struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *Violations) Add(field, format string, args ...interface{}) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err returns the collected violations as an *Error, or nil if there are
+// none.
+func (v *Violations) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: v.violations}
+}
+
+// Required checks that value is not blank.
+func (v *Violations) Required(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.Add(field, "is required")
+		return false
+	}
+	return true
+}
+
+// OneOf checks that value is one of allowed.
+func (v *Violations) OneOf(field, value string, allowed ...string) {
+	if !v.Required(field, value) {
+		return
+	}
+	if !slices.Contains(allowed, value) {
+		v.Add(field, "must be one of %s", strings.Join(allowed, ", "))
+	}
+}
+
+// OptionalOneOf is OneOf for a field that may be left empty.
+func (v *Violations) OptionalOneOf(field, value string, allowed ...string) {
+	if value != "" {
+		v.OneOf(field, value, allowed...)
+	}
+}
+
+// ID checks that value is a well-formed document id.
+func (v *Violations) ID(field, value string) {
+	if !v.Required(field, value) {
+		return
+	}
+	if !primitive.IsValidObjectID(value) {
+		v.Add(field, "%q is not a valid id", value)
+	}
+}
+
+// OptionalID is ID for a field that may be left empty.
+func (v *Violations) OptionalID(field, value string) {
+	if value != "" {
+		v.ID(field, value)
+	}
+}
+
+// Date checks that value is a YYYY-MM-DD date and returns it.
+func (v *Violations) Date(field, value string) time.Time {
+	if !v.Required(field, value) {
+		return time.Time{}
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		v.Add(field, "%q is not a date in the form YYYY-MM-DD", value)
+		return time.Time{}
+	}
+	return date
+}
+
+// OptionalDate is Date for a field that may be left empty, in which case it
+// returns the zero time.
+func (v *Violations) OptionalDate(field, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	return v.Date(field, value)
+}
+
+// NotBefore checks that the date in field does not come before the one in
+// startField. Dates that are missing or invalid are left to Date to report.
+func (v *Violations) NotBefore(field string, date time.Time, startField string, start time.Time) {
+	if !date.IsZero() && !start.IsZero() && date.Before(start) {
+		v.Add(field, "must not be before %s", startField)
+	}
+}
+
+// Currency checks that code is an ISO 4217 currency code and returns it the
+// way rates are stored, trimmed and upper case, or "" if it is not one.
+func (v *Violations) Currency(field, code string) string {
+	if !v.Required(field, code) {
+		return ""
+	}
+	currency, err := exchange.Currency(code)
+	if err != nil {
+		v.Add(field, "%q is not a currency code", code)
+		return ""
+	}
+	return currency
+}
+
+// Money checks that amount is given and well-formed.
+func (v *Violations) Money(field string, amount *common_pb.Money) (int64, bool) {
+	if amount == nil {
+		v.Add(field, "is required")
+		return 0, false
+	}
+	cents, err := money.FromProto(amount)
+	if err != nil {
+		v.Add(field, "%v", err)
+		return 0, false
+	}
+	return cents, true
+}
+
+// Positive checks that amount is given and greater than zero.
+func (v *Violations) Positive(field string, amount *common_pb.Money) {
+	if cents, ok := v.Money(field, amount); ok && cents <= 0 {
+		v.Add(field, "must be positive")
+	}
+}
+
+// OptionalPositive is Positive for an amount that may be left unset.
+func (v *Violations) OptionalPositive(field string, amount *common_pb.Money) {
+	if amount != nil {
+		v.Positive(field, amount)
+	}
+}